@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+var errMalformedAllowedSigners = errors.New("malformed allowed signers")
+
+// allowedSigner is a line of an allowed signers file (ssh-keygen(1), ALLOWED SIGNERS): principal patterns,
+// options and a public key.
+type allowedSigner struct {
+	principals    []string
+	publicKey     ssh.PublicKey
+	certAuthority bool
+	namespaces    []string
+	validAfter    time.Time
+	validBefore   time.Time
+}
+
+func parseAllowedSigners(r io.Reader) ([]*allowedSigner, error) {
+	var signers []*allowedSigner
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		signer, err := parseAllowedSigner(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		signers = append(signers, signer)
+	}
+	return signers, scanner.Err()
+}
+
+func parseAllowedSigner(line string) (*allowedSigner, error) {
+	principals, rest := splitField(line)
+	principals = strings.Trim(principals, `"`)
+	if principals == "" || rest == "" {
+		return nil, fmt.Errorf("%w: no public key", errMalformedAllowedSigners)
+	}
+
+	// The rest of the line is an authorized_keys line: options followed by the key.
+	publicKey, _, options, _, err := ssh.ParseAuthorizedKey([]byte(rest))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errMalformedAllowedSigners, err)
+	}
+
+	signer := &allowedSigner{principals: strings.Split(principals, ","), publicKey: publicKey}
+	for _, option := range options {
+		name, value, _ := strings.Cut(option, "=")
+		value = strings.Trim(value, `"`)
+
+		switch strings.ToLower(name) {
+		case "cert-authority":
+			signer.certAuthority = true
+		case "namespaces":
+			signer.namespaces = strings.Split(value, ",")
+		case "valid-after":
+			if signer.validAfter, err = parseSSHTime(value); err != nil {
+				return nil, err
+			}
+		case "valid-before":
+			if signer.validBefore, err = parseSSHTime(value); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("%w: unknown option %q", errMalformedAllowedSigners, name)
+		}
+	}
+	return signer, nil
+}
+
+// splitField returns the first whitespace separated field of line, which may be double quoted, and the rest of line.
+func splitField(line string) (string, string) {
+	quoted := false
+	for i, c := range line {
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case !quoted && (c == ' ' || c == '\t'):
+			return line[:i], strings.TrimSpace(line[i:])
+		}
+	}
+	return line, ""
+}
+
+// matches tells whether the signer may sign as principal within namespace at verifyTime. An empty principal
+// matches any.
+func (s *allowedSigner) matches(publicKey ssh.PublicKey, principal, namespace string, verifyTime time.Time) bool {
+	// Certificate authorities sign certificates, which the service keys are not.
+	if s.certAuthority || !bytes.Equal(s.publicKey.Marshal(), publicKey.Marshal()) {
+		return false
+	}
+
+	if principal != "" && !matchPattern(s.principals, principal) {
+		return false
+	}
+
+	if namespace != "" && s.namespaces != nil && !matchPattern(s.namespaces, namespace) {
+		return false
+	}
+
+	if !s.validAfter.IsZero() && verifyTime.Before(s.validAfter) {
+		return false
+	}
+	return s.validBefore.IsZero() || verifyTime.Before(s.validBefore)
+}
+
+// matchPattern matches value against a list of glob patterns, where a pattern prefixed by "!" negates a match.
+func matchPattern(patterns []string, value string) bool {
+	matched := false
+	for _, pattern := range patterns {
+		negated := strings.HasPrefix(pattern, "!")
+		if ok, _ := path.Match(strings.TrimPrefix(pattern, "!"), value); ok {
+			if negated {
+				return false
+			}
+			matched = true
+		}
+	}
+	return matched
+}
+
+// parseSSHTime parses the YYYYMMDD[HHMM[SS]][Z] times of allowed signers options and of -O verify-time. Times
+// are local unless suffixed with Z.
+func parseSSHTime(value string) (time.Time, error) {
+	location := time.Local
+	if strings.HasSuffix(value, "Z") || strings.HasSuffix(value, "z") {
+		value, location = value[:len(value)-1], time.UTC
+	}
+
+	var layout string
+	switch len(value) {
+	case 8:
+		layout = "20060102"
+	case 12:
+		layout = "200601021504"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, fmt.Errorf("%w: invalid time %q", errMalformedAllowedSigners, value)
+	}
+
+	t, err := time.ParseInLocation(layout, value, location)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid time %q", errMalformedAllowedSigners, value)
+	}
+	return t, nil
+}
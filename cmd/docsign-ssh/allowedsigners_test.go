@@ -0,0 +1,106 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	_testKey  = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIC1E8R5+tVbuC6TNUJXGVZJvzmUZBfp5EUJCTLEg3jF"
+	_otherKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIGTcmzPZ84LxVegnxTf73HWTW0Uci1z8SvKHtwfuY6M7"
+)
+
+func TestParseAllowedSigners(t *testing.T) {
+	t.Parallel()
+
+	signers, err := parseAllowedSigners(strings.NewReader(`
+# comment
+alice@example.com,*@ci.example.com ` + _testKey + ` alice
+"bob@example.com" namespaces="git,file",valid-after="20240101",valid-before="20250101Z" ` + _testKey + `
+*@example.com cert-authority ` + _testKey + `
+`))
+	assert.NoError(t, err)
+	assert.Len(t, signers, 3)
+
+	assert.Equal(t, []string{"alice@example.com", "*@ci.example.com"}, signers[0].principals)
+	assert.Equal(t, []string{"bob@example.com"}, signers[1].principals)
+	assert.Equal(t, []string{"git", "file"}, signers[1].namespaces)
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), signers[1].validBefore)
+	assert.True(t, signers[2].certAuthority)
+
+	_, err = parseAllowedSigners(strings.NewReader("alice@example.com\n"))
+	assert.Error(t, err)
+
+	_, err = parseAllowedSigners(strings.NewReader(`alice@example.com valid-after="2024" ` + _testKey))
+	assert.Error(t, err)
+
+	_, err = parseAllowedSigners(strings.NewReader(`alice@example.com unknown ` + _testKey))
+	assert.Error(t, err)
+}
+
+func TestAllowedSigner_Matches(t *testing.T) {
+	t.Parallel()
+
+	testKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(_testKey))
+	assert.NoError(t, err)
+	otherKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(_otherKey))
+	assert.NoError(t, err)
+
+	signer, err := parseAllowedSigner(`*@example.com,!eve@example.com namespaces="git",valid-before="20250101Z" ` + _testKey)
+	assert.NoError(t, err)
+
+	before := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		publicKey ssh.PublicKey
+		principal string
+		namespace string
+		time      time.Time
+		matches   bool
+	}{
+		{name: "Case #1", publicKey: testKey, principal: "alice@example.com", namespace: "git", time: before, matches: true},
+		{name: "Case #2", publicKey: testKey, principal: "", namespace: "", time: before, matches: true},
+		{name: "Case #3", publicKey: otherKey, principal: "alice@example.com", namespace: "git", time: before, matches: false},
+		{name: "Case #4", publicKey: testKey, principal: "eve@example.com", namespace: "git", time: before, matches: false},
+		{name: "Case #5", publicKey: testKey, principal: "alice@example.org", namespace: "git", time: before, matches: false},
+		{name: "Case #6", publicKey: testKey, principal: "alice@example.com", namespace: "file", time: before, matches: false},
+		{name: "Case #7", publicKey: testKey, principal: "alice@example.com", namespace: "git", time: after, matches: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.matches, signer.matches(tt.publicKey, tt.principal, tt.namespace, tt.time))
+		})
+	}
+}
+
+func TestParseOptions(t *testing.T) {
+	t.Parallel()
+
+	opts, err := parseOptions([]string{"-Y", "verify", "-n", "git", "-f", "allowed", "-I", "alice@example.com", "-s", "sig",
+		"-Overify-time=20240102030405Z"})
+	assert.NoError(t, err)
+	assert.Equal(t, "verify", opts.operation)
+	assert.Equal(t, "git", opts.namespace)
+	assert.Equal(t, "allowed", opts.file)
+	assert.Equal(t, "alice@example.com", opts.principal)
+	assert.Equal(t, "sig", opts.signature)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), opts.verifyTime)
+
+	opts, err = parseOptions([]string{"-Ysign", "-n", "git", "-f", "/tmp/key", "-U", "/tmp/buffer"})
+	assert.NoError(t, err)
+	assert.Equal(t, "sign", opts.operation)
+	assert.Equal(t, []string{"/tmp/buffer"}, opts.args)
+
+	_, err = parseOptions([]string{"-Y"})
+	assert.Error(t, err)
+
+	_, err = parseOptions([]string{"-x", "value"})
+	assert.Error(t, err)
+}
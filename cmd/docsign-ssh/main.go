@@ -0,0 +1,367 @@
+// Command docsign-ssh signs with the docsign service key in the SSHSIG format and verifies SSHSIG signatures. It
+// takes the ssh-keygen -Y arguments git passes to gpg.ssh.program:
+//
+//	docsign-ssh -Y sign -n <namespace> [-f <public key>] [-U] [file ...]
+//	docsign-ssh -Y verify -n <namespace> -f <allowed signers> -I <principal> -s <signature> [-O verify-time=<time>]
+//	docsign-ssh -Y find-principals -f <allowed signers> -s <signature> [-O verify-time=<time>]
+//	docsign-ssh -Y check-novalidate -n <namespace> -s <signature>
+//
+// Signing calls SignService.SignSSH at $DOCSIGN_ADDR with the bearer token $DOCSIGN_TOKEN. Verification needs
+// public keys only and runs locally against the allowed signers file, so signatures of any key it lists verify.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+	"github.com/r4start/sign-service/pkg/sshsig"
+)
+
+const (
+	_addrEnv     = "DOCSIGN_ADDR"
+	_tokenEnv    = "DOCSIGN_TOKEN"
+	_defaultAddr = "localhost:10116"
+
+	_timeout = 30 * time.Second
+
+	_signatureExtension = ".sig"
+)
+
+var errUsage = errors.New("usage: docsign-ssh -Y sign|verify|find-principals|check-novalidate [options] [file ...]")
+
+type options struct {
+	operation  string
+	namespace  string
+	file       string
+	principal  string
+	signature  string
+	verifyTime time.Time
+	args       []string
+}
+
+func main() {
+	opts, err := parseOptions(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	switch opts.operation {
+	case "sign":
+		err = sign(opts)
+	case "verify":
+		err = verify(opts)
+	case "find-principals":
+		err = findPrincipals(opts)
+	case "check-novalidate":
+		err = checkNoValidate(opts)
+	default:
+		err = errUsage
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// parseOptions parses ssh-keygen style options: single letter flags with their values attached or in the next
+// argument, up to the first non-option argument.
+func parseOptions(args []string) (*options, error) {
+	opts := &options{verifyTime: time.Now()}
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") && args[0] != "-" {
+		flag := args[0]
+		args = args[1:]
+		if flag == "--" {
+			break
+		}
+
+		name := flag[1]
+		if name == 'U' {
+			// The key is in an agent: docsign signs with the service key anyway.
+			continue
+		}
+
+		value := flag[2:]
+		if value == "" {
+			if len(args) == 0 {
+				return nil, fmt.Errorf("option -%c requires a value", name)
+			}
+			value, args = args[0], args[1:]
+		}
+
+		switch name {
+		case 'Y':
+			opts.operation = value
+		case 'n':
+			opts.namespace = value
+		case 'f':
+			opts.file = value
+		case 'I':
+			opts.principal = value
+		case 's':
+			opts.signature = value
+		case 'O':
+			option, optionValue, _ := strings.Cut(value, "=")
+			if option != "verify-time" {
+				// Other options, e.g. hashalg or print-pubkey, do not change the result.
+				continue
+			}
+
+			verifyTime, err := parseSSHTime(optionValue)
+			if err != nil {
+				return nil, err
+			}
+			opts.verifyTime = verifyTime
+		default:
+			return nil, fmt.Errorf("unknown option -%c", name)
+		}
+	}
+
+	opts.args = args
+	return opts, nil
+}
+
+// sign writes the signature of each file to the file with a .sig suffix, or the signature of the standard input
+// to the standard output.
+func sign(opts *options) error {
+	if opts.namespace == "" {
+		return errors.New("missing namespace")
+	}
+
+	var expected ssh.PublicKey
+	if opts.file != "" {
+		data, err := os.ReadFile(opts.file)
+		if err != nil {
+			return err
+		}
+
+		if expected, _, _, _, err = ssh.ParseAuthorizedKey(data); err != nil {
+			return fmt.Errorf("%s: not a public key: %w", opts.file, err)
+		}
+	}
+
+	client, closeClient, err := dial()
+	if err != nil {
+		return err
+	}
+	defer closeClient()
+
+	signData := func(data []byte) ([]byte, error) {
+		ctx, cancel := callContext()
+		defer cancel()
+
+		response, err := client.SignSSH(ctx, &pb.SignSSHRequest{Data: data, Namespace: opts.namespace})
+		if err != nil {
+			return nil, err
+		}
+
+		if expected != nil {
+			publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(response.PublicKey))
+			if err != nil {
+				return nil, err
+			}
+
+			if string(publicKey.Marshal()) != string(expected.Marshal()) {
+				return nil, fmt.Errorf("%s is not the service key %s", opts.file, ssh.FingerprintSHA256(publicKey))
+			}
+		}
+		return []byte(response.Signature), nil
+	}
+
+	if len(opts.args) == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+
+		signature, err := signData(data)
+		if err != nil {
+			return err
+		}
+
+		_, err = os.Stdout.Write(signature)
+		return err
+	}
+
+	for _, file := range opts.args {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		signature, err := signData(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		if err := os.WriteFile(file+_signatureExtension, signature, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verify checks the signature of the standard input against the keys the allowed signers file lists for the
+// principal.
+func verify(opts *options) error {
+	if opts.namespace == "" || opts.file == "" || opts.principal == "" || opts.signature == "" {
+		return errUsage
+	}
+
+	signature, signers, err := readSignature(opts)
+	if err != nil {
+		return err
+	}
+
+	allowed := false
+	for _, signer := range signers {
+		if signer.matches(signature.PublicKey, opts.principal, opts.namespace, opts.verifyTime) {
+			allowed = true
+			break
+		}
+	}
+
+	if !allowed {
+		return fmt.Errorf("no principal matched %s key %s", keyType(signature.PublicKey),
+			ssh.FingerprintSHA256(signature.PublicKey))
+	}
+
+	if err := checkSignature(signature, opts.namespace); err != nil {
+		return err
+	}
+
+	fmt.Printf("Good %q signature for %s with %s key %s\n", opts.namespace, opts.principal,
+		keyType(signature.PublicKey), ssh.FingerprintSHA256(signature.PublicKey))
+	return nil
+}
+
+// findPrincipals prints the principals the allowed signers file lists for the key of the signature.
+func findPrincipals(opts *options) error {
+	if opts.file == "" || opts.signature == "" {
+		return errUsage
+	}
+
+	signature, signers, err := readSignature(opts)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, signer := range signers {
+		if signer.matches(signature.PublicKey, "", "", opts.verifyTime) {
+			fmt.Println(strings.Join(signer.principals, ","))
+			found = true
+		}
+	}
+
+	if !found {
+		return errors.New("no principal matched")
+	}
+	return nil
+}
+
+// checkNoValidate checks the signature of the standard input without checking that its key is trusted.
+func checkNoValidate(opts *options) error {
+	if opts.namespace == "" || opts.signature == "" {
+		return errUsage
+	}
+
+	signature, _, err := readSignature(opts)
+	if err != nil {
+		return err
+	}
+
+	if err := checkSignature(signature, opts.namespace); err != nil {
+		return err
+	}
+
+	fmt.Printf("Good %q signature with %s key %s\n", opts.namespace, keyType(signature.PublicKey),
+		ssh.FingerprintSHA256(signature.PublicKey))
+	return nil
+}
+
+// readSignature reads the signature file and, when one is given, the allowed signers file.
+func readSignature(opts *options) (*sshsig.Signature, []*allowedSigner, error) {
+	data, err := os.ReadFile(opts.signature)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signature, err := sshsig.ParseArmored(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if opts.operation == "check-novalidate" {
+		return signature, nil, nil
+	}
+
+	file, err := os.Open(opts.file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	signers, err := parseAllowedSigners(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", opts.file, err)
+	}
+	return signature, signers, nil
+}
+
+func checkSignature(signature *sshsig.Signature, namespace string) error {
+	message, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+
+	if err := signature.Verify(namespace, message); err != nil {
+		return fmt.Errorf("could not verify signature: %w", err)
+	}
+	return nil
+}
+
+// keyType names a key type the way ssh-keygen reports it.
+func keyType(publicKey ssh.PublicKey) string {
+	switch publicKey.Type() {
+	case ssh.KeyAlgoED25519:
+		return "ED25519"
+	case ssh.KeyAlgoRSA:
+		return "RSA"
+	default:
+		return "ECDSA"
+	}
+}
+
+func dial() (pb.SignServiceClient, func(), error) {
+	addr := os.Getenv(_addrEnv)
+	if addr == "" {
+		addr = _defaultAddr
+	}
+
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, nil, err
+	}
+	return pb.NewSignServiceClient(conn), func() { conn.Close() }, nil
+}
+
+func callContext() (context.Context, context.CancelFunc) {
+	ctx := context.Background()
+	if token := os.Getenv(_tokenEnv); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "bearer "+token)
+	}
+	return context.WithTimeout(ctx, _timeout)
+}
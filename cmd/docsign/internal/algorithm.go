@@ -0,0 +1,367 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cloudflare/circl/sign"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+// Algorithm is a signature scheme together with its key parameters and digest.
+type Algorithm int
+
+const (
+	AlgorithmUnknown Algorithm = iota
+	AlgorithmEd25519
+	AlgorithmECDSAP256SHA256
+	AlgorithmECDSAP384SHA384
+	AlgorithmRSAPSS2048SHA256
+	AlgorithmRSAPSS3072SHA256
+	AlgorithmRSAPSS4096SHA256
+	AlgorithmMLDSA44
+	AlgorithmMLDSA65
+	AlgorithmMLDSA87
+	// AlgorithmEd25519MLDSA65 signs with an Ed25519 and an ML-DSA-65 key, see HybridPrivateKey.
+	AlgorithmEd25519MLDSA65
+)
+
+var (
+	ErrUnknownAlgorithm  = errors.New("unknown signature algorithm")
+	ErrUnsupportedDigest = errors.New("unsupported digest")
+)
+
+var _algorithmNames = map[Algorithm]string{
+	AlgorithmEd25519:          "ed25519",
+	AlgorithmECDSAP256SHA256:  "ecdsa-p256",
+	AlgorithmECDSAP384SHA384:  "ecdsa-p384",
+	AlgorithmRSAPSS2048SHA256: "rsa-pss-2048",
+	AlgorithmRSAPSS3072SHA256: "rsa-pss-3072",
+	AlgorithmRSAPSS4096SHA256: "rsa-pss-4096",
+	AlgorithmMLDSA44:          "ml-dsa-44",
+	AlgorithmMLDSA65:          "ml-dsa-65",
+	AlgorithmMLDSA87:          "ml-dsa-87",
+	AlgorithmEd25519MLDSA65:   "ed25519-ml-dsa-65",
+}
+
+func (a Algorithm) String() string {
+	if name, ok := _algorithmNames[a]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseAlgorithm is the inverse of Algorithm.String.
+func ParseAlgorithm(name string) (Algorithm, error) {
+	for algorithm, algorithmName := range _algorithmNames {
+		if algorithmName == name {
+			return algorithm, nil
+		}
+	}
+	return AlgorithmUnknown, fmt.Errorf("%w: %s", ErrUnknownAlgorithm, name)
+}
+
+// AlgorithmOf tells the algorithm a public key is used with.
+func AlgorithmOf(publicKey crypto.PublicKey) (Algorithm, error) {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return AlgorithmEd25519, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return AlgorithmECDSAP256SHA256, nil
+		case elliptic.P384():
+			return AlgorithmECDSAP384SHA384, nil
+		}
+		return AlgorithmUnknown, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, key.Curve.Params().Name)
+	case *rsa.PublicKey:
+		switch key.N.BitLen() {
+		case 2048:
+			return AlgorithmRSAPSS2048SHA256, nil
+		case 3072:
+			return AlgorithmRSAPSS3072SHA256, nil
+		case 4096:
+			return AlgorithmRSAPSS4096SHA256, nil
+		}
+		return AlgorithmUnknown, fmt.Errorf("%w: RSA %d bits", ErrUnsupportedKey, key.N.BitLen())
+	case sign.PublicKey:
+		return mldsaAlgorithm(key.Scheme())
+	case *HybridPublicKey:
+		return AlgorithmEd25519MLDSA65, nil
+	default:
+		return AlgorithmUnknown, fmt.Errorf("%w: %T", ErrUnsupportedKey, publicKey)
+	}
+}
+
+// Hash returns the digest applied to a message before signing, zero for Ed25519, ML-DSA and hybrid keys which sign
+// messages directly.
+func (a Algorithm) Hash() crypto.Hash {
+	switch a {
+	case AlgorithmECDSAP256SHA256, AlgorithmRSAPSS2048SHA256, AlgorithmRSAPSS3072SHA256, AlgorithmRSAPSS4096SHA256:
+		return crypto.SHA256
+	case AlgorithmECDSAP384SHA384:
+		return crypto.SHA384
+	default:
+		return 0
+	}
+}
+
+// digest hashes message with the algorithm digest, messages of algorithms without a digest are returned as is.
+func (a Algorithm) digest(message []byte) []byte {
+	hash := a.Hash()
+	if hash == 0 {
+		return message
+	}
+
+	hasher := hash.New()
+	hasher.Write(message)
+	return hasher.Sum(nil)
+}
+
+func (a Algorithm) isRSA() bool {
+	return a == AlgorithmRSAPSS2048SHA256 || a == AlgorithmRSAPSS3072SHA256 || a == AlgorithmRSAPSS4096SHA256
+}
+
+func (a Algorithm) signerOpts() crypto.SignerOpts {
+	if a.isRSA() {
+		return &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: a.Hash()}
+	}
+	return a.Hash()
+}
+
+// Sign signs message with signer. ECDSA signatures are ASN.1 DER encoded, hybrid signatures are composite.
+func (a Algorithm) Sign(signer crypto.Signer, message []byte) ([]byte, error) {
+	if a == AlgorithmUnknown {
+		return nil, ErrUnknownAlgorithm
+	}
+
+	return signer.Sign(rand.Reader, a.digest(message), a.signerOpts())
+}
+
+// Verify checks the signature of message. It never panics on a key of a wrong type.
+func (a Algorithm) Verify(publicKey crypto.PublicKey, message, signature []byte) bool {
+	if algorithm, err := AlgorithmOf(publicKey); err != nil || algorithm != a {
+		return false
+	}
+
+	digest := a.digest(message)
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, signature)
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPSS(key, a.Hash(), digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+	case sign.PublicKey:
+		return key.Scheme().Verify(key, message, signature, nil)
+	case *HybridPublicKey:
+		return key.Verify(message, signature)
+	default:
+		return false
+	}
+}
+
+// digestOpts tells how a digest made with hash is signed: with Ed25519ph for Ed25519 keys, as is for ECDSA and RSA-PSS.
+func (a Algorithm) digestOpts(hash crypto.Hash, digest []byte) (crypto.SignerOpts, error) {
+	if hash != crypto.SHA256 && hash != crypto.SHA384 && hash != crypto.SHA512 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDigest, hash)
+	}
+
+	if len(digest) != hash.Size() {
+		return nil, fmt.Errorf("%w: %d bytes long %s digest", ErrUnsupportedDigest, len(digest), hash)
+	}
+
+	switch {
+	case a == AlgorithmEd25519:
+		if hash != crypto.SHA512 {
+			return nil, fmt.Errorf("%w: Ed25519ph signs SHA-512 digests only", ErrUnsupportedDigest)
+		}
+		return &ed25519.Options{Hash: crypto.SHA512}, nil
+	case a == AlgorithmECDSAP256SHA256, a == AlgorithmECDSAP384SHA384:
+		return hash, nil
+	case a.isRSA():
+		return &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: hash}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s keys sign messages only", ErrUnsupportedDigest, a)
+	}
+}
+
+// prehash is the digest used for documents which are hashed before signing when the client does not choose one.
+func (a Algorithm) prehash() crypto.Hash {
+	switch {
+	case a == AlgorithmEd25519:
+		return crypto.SHA512
+	case a == AlgorithmECDSAP256SHA256, a == AlgorithmECDSAP384SHA384, a.isRSA():
+		return a.Hash()
+	default:
+		return 0
+	}
+}
+
+// SignDigest signs a SHA-256, SHA-384 or SHA-512 digest made by the caller, see digestOpts.
+func (a Algorithm) SignDigest(signer crypto.Signer, hash crypto.Hash, digest []byte) ([]byte, error) {
+	opts, err := a.digestOpts(hash, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.Sign(rand.Reader, digest, opts)
+}
+
+// VerifyDigest checks a signature made by SignDigest.
+func (a Algorithm) VerifyDigest(publicKey crypto.PublicKey, hash crypto.Hash, digest, signature []byte) bool {
+	if algorithm, err := AlgorithmOf(publicKey); err != nil || algorithm != a {
+		return false
+	}
+
+	opts, err := a.digestOpts(hash, digest)
+	if err != nil {
+		return false
+	}
+
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return ed25519.VerifyWithOptions(key, digest, signature, opts.(*ed25519.Options)) == nil
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest, signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPSS(key, hash, digest, signature, opts.(*rsa.PSSOptions)) == nil
+	default:
+		return false
+	}
+}
+
+// ecdsaSize is the length of r and s of ECDSA signatures, zero for other algorithms.
+func (a Algorithm) ecdsaSize() int {
+	switch a {
+	case AlgorithmECDSAP256SHA256:
+		return 32
+	case AlgorithmECDSAP384SHA384:
+		return 48
+	default:
+		return 0
+	}
+}
+
+// rawSignature converts an ASN.1 DER ECDSA signature into the fixed size r || s form used by JWS and COSE.
+// Signatures of other algorithms are returned as is.
+func (a Algorithm) rawSignature(signature []byte) ([]byte, error) {
+	size := a.ecdsaSize()
+	if size == 0 {
+		return signature, nil
+	}
+
+	var parsed struct{ R, S *big.Int }
+	if rest, err := asn1.Unmarshal(signature, &parsed); err != nil || len(rest) != 0 {
+		return nil, errors.New("malformed ECDSA signature")
+	}
+
+	if parsed.R.BitLen() > 8*size || parsed.S.BitLen() > 8*size {
+		return nil, errors.New("malformed ECDSA signature")
+	}
+
+	raw := make([]byte, 2*size)
+	parsed.R.FillBytes(raw[:size])
+	parsed.S.FillBytes(raw[size:])
+	return raw, nil
+}
+
+// asn1Signature is the inverse of rawSignature.
+func (a Algorithm) asn1Signature(signature []byte) ([]byte, error) {
+	size := a.ecdsaSize()
+	if size == 0 {
+		return signature, nil
+	}
+
+	if len(signature) != 2*size {
+		return nil, fmt.Errorf("ECDSA signature of %d bytes", len(signature))
+	}
+
+	return asn1.Marshal(struct{ R, S *big.Int }{
+		R: new(big.Int).SetBytes(signature[:size]),
+		S: new(big.Int).SetBytes(signature[size:]),
+	})
+}
+
+// GenerateKey creates a new private key for the algorithm.
+func (a Algorithm) GenerateKey() (crypto.Signer, error) {
+	switch a {
+	case AlgorithmEd25519:
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		return privateKey, err
+	case AlgorithmECDSAP256SHA256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmECDSAP384SHA384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case AlgorithmRSAPSS2048SHA256:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case AlgorithmRSAPSS3072SHA256:
+		return rsa.GenerateKey(rand.Reader, 3072)
+	case AlgorithmRSAPSS4096SHA256:
+		return rsa.GenerateKey(rand.Reader, 4096)
+	case AlgorithmMLDSA44, AlgorithmMLDSA65, AlgorithmMLDSA87:
+		return generateMLDSAKey(a)
+	case AlgorithmEd25519MLDSA65:
+		return GenerateHybridKey(rand.Reader)
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+}
+
+func algorithmProto(algorithm Algorithm) pb.Algorithm {
+	switch algorithm {
+	case AlgorithmEd25519:
+		return pb.Algorithm_ALGORITHM_ED25519
+	case AlgorithmECDSAP256SHA256:
+		return pb.Algorithm_ALGORITHM_ECDSA_P256_SHA256
+	case AlgorithmECDSAP384SHA384:
+		return pb.Algorithm_ALGORITHM_ECDSA_P384_SHA384
+	case AlgorithmRSAPSS2048SHA256:
+		return pb.Algorithm_ALGORITHM_RSA_PSS_2048_SHA256
+	case AlgorithmRSAPSS3072SHA256:
+		return pb.Algorithm_ALGORITHM_RSA_PSS_3072_SHA256
+	case AlgorithmRSAPSS4096SHA256:
+		return pb.Algorithm_ALGORITHM_RSA_PSS_4096_SHA256
+	case AlgorithmMLDSA44:
+		return pb.Algorithm_ALGORITHM_ML_DSA_44
+	case AlgorithmMLDSA65:
+		return pb.Algorithm_ALGORITHM_ML_DSA_65
+	case AlgorithmMLDSA87:
+		return pb.Algorithm_ALGORITHM_ML_DSA_87
+	case AlgorithmEd25519MLDSA65:
+		return pb.Algorithm_ALGORITHM_ED25519_ML_DSA_65
+	default:
+		return pb.Algorithm_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func algorithmFromProto(algorithm pb.Algorithm) Algorithm {
+	for a := range _algorithmNames {
+		if algorithmProto(a) == algorithm {
+			return a
+		}
+	}
+	return AlgorithmUnknown
+}
+
+func hashFromProto(hash pb.HashAlgorithm) crypto.Hash {
+	switch hash {
+	case pb.HashAlgorithm_HASH_ALGORITHM_SHA256:
+		return crypto.SHA256
+	case pb.HashAlgorithm_HASH_ALGORITHM_SHA384:
+		return crypto.SHA384
+	case pb.HashAlgorithm_HASH_ALGORITHM_SHA512:
+		return crypto.SHA512
+	default:
+		return 0
+	}
+}
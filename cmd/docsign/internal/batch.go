@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+var ErrBatchLimit = errors.New("batch limit exceeded")
+
+const (
+	// _grpcMessageSize is the default receive limit of gRPC servers and clients.
+	_grpcMessageSize = 4 << 20
+	// _batchItemHeadroom is room for the identifier, signature and proof of every item next to its document, the
+	// largest signatures, ML-DSA-87 and hybrid ones, are below 5 KiB.
+	_batchItemHeadroom = 8 << 10
+)
+
+// BatchLimits bound the documents of a SignBatch or VerifyBatch call. Documents beyond them are rejected one by one,
+// the others are still signed or checked.
+type BatchLimits struct {
+	// MaxItems is the number of documents of a batch, zero for no limit.
+	MaxItems int
+	// MaxBytes is the total size of the documents of a batch, zero for no limit. A document which would take the
+	// batch over it is rejected, later smaller documents may still fit.
+	MaxBytes int
+}
+
+// DefaultBatchLimits allow 4 MiB of documents, see MessageSize for the gRPC message size this takes.
+var DefaultBatchLimits = BatchLimits{MaxItems: 1000, MaxBytes: 4 << 20}
+
+// MessageSize is the gRPC message size limit of the batch service which lets a batch go over MaxBytes, so the
+// documents beyond it are rejected one by one instead of the whole call: twice MaxBytes of documents and the headroom
+// of MaxItems items, DefaultBatchLimits.MaxItems when there is no item limit, but never less than the gRPC default.
+// Without a byte limit it is the gRPC default, which then bounds the batch.
+func (l BatchLimits) MessageSize() int {
+	if l.MaxBytes <= 0 {
+		return _grpcMessageSize
+	}
+
+	items := l.MaxItems
+	if items <= 0 {
+		items = DefaultBatchLimits.MaxItems
+	}
+	return max(2*l.MaxBytes+items*_batchItemHeadroom, _grpcMessageSize)
+}
+
+// WithBatchLimits sets the limits of SignBatch and VerifyBatch, DefaultBatchLimits by default.
+func WithBatchLimits(limits BatchLimits) ServerOption {
+	return func(server *GrpcDocSignServer) {
+		server.batchLimits = limits
+	}
+}
+
+// BatchService returns a service of nothing but SignBatch and VerifyBatch of server. It is meant for a gRPC server of
+// its own with the message size limit of MessageSize, so the other calls keep the gRPC default.
+func (server *GrpcDocSignServer) BatchService() pb.SignServiceServer {
+	return &batchService{server: server}
+}
+
+type batchService struct {
+	pb.UnimplementedSignServiceServer
+	server *GrpcDocSignServer
+}
+
+func (s *batchService) SignBatch(ctx context.Context, docs *pb.DocumentBatch) (*pb.DocSignBatch, error) {
+	return s.server.SignBatch(ctx, docs)
+}
+
+func (s *batchService) VerifyBatch(ctx context.Context, signs *pb.VerifyBatchRequest) (*pb.VerifyBatchResponse, error) {
+	return s.server.VerifyBatch(ctx, signs)
+}
+
+// NewBatchRoutingClient returns a client which sends SignBatch and VerifyBatch to batchClient, a client of
+// BatchService, and every other call to client.
+func NewBatchRoutingClient(client, batchClient pb.SignServiceClient) pb.SignServiceClient {
+	return &batchRoutingClient{SignServiceClient: client, batch: batchClient}
+}
+
+type batchRoutingClient struct {
+	pb.SignServiceClient
+	batch pb.SignServiceClient
+}
+
+func (c *batchRoutingClient) SignBatch(ctx context.Context, in *pb.DocumentBatch, opts ...grpc.CallOption) (*pb.DocSignBatch, error) {
+	return c.batch.SignBatch(ctx, in, opts...)
+}
+
+func (c *batchRoutingClient) VerifyBatch(ctx context.Context, in *pb.VerifyBatchRequest, opts ...grpc.CallOption) (*pb.VerifyBatchResponse, error) {
+	return c.batch.VerifyBatch(ctx, in, opts...)
+}
+
+// admit checks the documents of a batch of the given sizes in order and returns the error of every document which is
+// beyond the limits, nil for those within them.
+func (l BatchLimits) admit(sizes []int) []error {
+	errs := make([]error, len(sizes))
+	total := 0
+	for i, size := range sizes {
+		switch {
+		case l.MaxItems > 0 && i >= l.MaxItems:
+			errs[i] = fmt.Errorf("%w: more than %d documents", ErrBatchLimit, l.MaxItems)
+		case l.MaxBytes > 0 && total+size > l.MaxBytes:
+			errs[i] = fmt.Errorf("%w: %d byte document over the %d byte batch size", ErrBatchLimit, size, l.MaxBytes)
+		default:
+			total += size
+		}
+	}
+	return errs
+}
+
+// batchDocuments returns the documents of a batch, those of doc without identifiers first.
+func batchDocuments(batch *pb.DocumentBatch) []*pb.BatchDocument {
+	documents := make([]*pb.BatchDocument, 0, len(batch.Doc)+len(batch.Items))
+	for _, doc := range batch.Doc {
+		documents = append(documents, &pb.BatchDocument{Data: doc})
+	}
+	return append(documents, batch.Items...)
+}
+
+// batchItemStatus converts the error of a batch item to the status of its result.
+func batchItemStatus(err error) *spb.Status {
+	if errors.Is(err, ErrBatchLimit) {
+		return status.New(codes.ResourceExhausted, err.Error()).Proto()
+	}
+	return status.Convert(err).Proto()
+}
@@ -0,0 +1,233 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+func TestBatchLimits_Admit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		limits   BatchLimits
+		sizes    []int
+		rejected []int
+	}{
+		{name: "Case #1", limits: BatchLimits{}, sizes: []int{1 << 30, 1 << 30, 0}, rejected: nil},
+		{name: "Case #2", limits: BatchLimits{MaxItems: 2}, sizes: []int{1, 2, 3, 4}, rejected: []int{2, 3}},
+		{name: "Case #3", limits: BatchLimits{MaxBytes: 10}, sizes: []int{4, 6, 1}, rejected: []int{2}},
+		{name: "Case #4", limits: BatchLimits{MaxBytes: 10}, sizes: []int{4, 7, 6, 0}, rejected: []int{1}},
+		{name: "Case #5", limits: BatchLimits{MaxBytes: 10}, sizes: []int{11, 10}, rejected: []int{0}},
+		{name: "Case #6", limits: BatchLimits{MaxItems: 2, MaxBytes: 10}, sizes: []int{8, 8, 1}, rejected: []int{1, 2}},
+		{name: "Case #7", limits: DefaultBatchLimits, sizes: nil, rejected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rejected []int
+			for i, err := range tt.limits.admit(tt.sizes) {
+				if err != nil {
+					assert.ErrorIs(t, err, ErrBatchLimit)
+					rejected = append(rejected, i)
+				}
+			}
+			assert.Equal(t, tt.rejected, rejected)
+		})
+	}
+}
+
+func TestBatchLimits_MessageSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		limits BatchLimits
+		size   int
+	}{
+		{name: "Case #1", limits: BatchLimits{}, size: _grpcMessageSize},
+		{name: "Case #2", limits: BatchLimits{MaxItems: 10}, size: _grpcMessageSize},
+		{name: "Case #3", limits: BatchLimits{MaxItems: 10, MaxBytes: 1024}, size: _grpcMessageSize},
+		{name: "Case #4", limits: BatchLimits{MaxItems: 10, MaxBytes: 8 << 20}, size: 16<<20 + 10*_batchItemHeadroom},
+		{name: "Case #5", limits: BatchLimits{MaxBytes: 8 << 20}, size: 16<<20 + DefaultBatchLimits.MaxItems*_batchItemHeadroom},
+		{name: "Case #6", limits: DefaultBatchLimits, size: 8<<20 + DefaultBatchLimits.MaxItems*_batchItemHeadroom},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.size, tt.limits.MessageSize())
+		})
+	}
+}
+
+func TestGrpcDocSignServer_BatchMessageSize(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	limits := BatchLimits{MaxItems: 10, MaxBytes: 5 << 20}
+
+	service, err := NewSignServer(NewKeyring(newTestKey(t)), WithBatchLimits(limits))
+	assert.NoError(t, err)
+
+	// serve sets the gRPC message size limits like main does, size is zero for the gRPC default.
+	serve := func(service pb.SignServiceServer, size int) (pb.SignServiceClient, func()) {
+		lis := bufconn.Listen(1024 * 1024)
+
+		var serverOptions []grpc.ServerOption
+		dialOptions := []grpc.DialOption{
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return lis.Dial()
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		}
+		if size > 0 {
+			serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(size))
+			dialOptions = append(dialOptions, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(size), grpc.MaxCallSendMsgSize(size)))
+		}
+
+		server := grpc.NewServer(serverOptions...)
+		pb.RegisterSignServiceServer(server, service)
+		go func() {
+			assert.NoError(t, server.Serve(lis))
+		}()
+
+		conn, err := grpc.DialContext(ctx, "", dialOptions...)
+		assert.NoError(t, err)
+
+		return pb.NewSignServiceClient(conn), func() {
+			assert.NoError(t, lis.Close())
+			server.Stop()
+		}
+	}
+
+	client, closer := serve(service, 0)
+	defer closer()
+
+	batchClient, batchCloser := serve(service.BatchService(), limits.MessageSize())
+	defer batchCloser()
+
+	// 6 MiB of documents are over MaxBytes and over the default 4 MiB message size.
+	batch := &pb.DocumentBatch{Doc: [][]byte{randData(t, 2<<20), randData(t, 2<<20), randData(t, 2<<20)}}
+
+	_, err = client.SignBatch(ctx, batch)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	routing := NewBatchRoutingClient(client, batchClient)
+	signs, err := routing.SignBatch(ctx, batch)
+	assert.NoError(t, err)
+	if !assert.Len(t, signs.GetResults(), 3) {
+		return
+	}
+	assert.Nil(t, signs.Results[0].GetError())
+	assert.Nil(t, signs.Results[1].GetError())
+	assert.Equal(t, int32(codes.ResourceExhausted), signs.Results[2].GetError().GetCode())
+
+	verification, err := routing.VerifyBatch(ctx, &pb.VerifyBatchRequest{Docs: []*pb.VerifyRequest{
+		{Doc: &pb.Document{Data: batch.Doc[0]}, Sign: &pb.DocSign{Sign: signs.Sign[0], KeyId: signs.KeyId}},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true}, verification.Status)
+
+	// The batch service has nothing but batches, the other calls keep the default message size.
+	_, err = batchClient.Sign(ctx, &pb.Document{Data: batch.Doc[0]})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = routing.Sign(ctx, &pb.Document{Data: append(batch.Doc[0], batch.Doc[1]...)})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = routing.Sign(ctx, &pb.Document{Data: batch.Doc[0]})
+	assert.NoError(t, err)
+}
+
+func TestGrpcDocSignServer_BatchItems(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	keyring := NewKeyring(newTestKey(t))
+	client, closer := serveKeyring(t, ctx, keyring, WithBatchLimits(BatchLimits{MaxItems: 4, MaxBytes: 100}))
+	defer closer()
+
+	docs := [][]byte{randData(t, 10), randData(t, 60)}
+	items := []*pb.BatchDocument{{Id: "a", Data: randData(t, 40)}, {Id: "b", Data: randData(t, 20)}, {Id: "c", Data: randData(t, 1)}}
+
+	// 10 + 60 + 40 bytes are over the limit, "c" is beyond 4 documents.
+	rejected := map[int]bool{2: true, 4: true}
+	ids := []string{"", "", "a", "b", "c"}
+
+	for _, merkleTree := range []bool{false, true} {
+		signs, err := client.SignBatch(ctx, &pb.DocumentBatch{Doc: docs, Items: items, MerkleTree: merkleTree})
+		assert.NoError(t, err)
+		assert.Len(t, signs.Results, len(ids))
+
+		documents := append(append([][]byte{}, docs...), items[0].Data, items[1].Data, items[2].Data)
+		request := &pb.VerifyBatchRequest{}
+		for i, result := range signs.Results {
+			assert.Equal(t, ids[i], result.Id)
+			if rejected[i] {
+				assert.Equal(t, int32(codes.ResourceExhausted), result.GetError().GetCode(), i)
+				continue
+			}
+			assert.Nil(t, result.GetError())
+
+			if !merkleTree {
+				assert.Equal(t, signs.Sign[i], result.GetSign())
+				request.Docs = append(request.Docs, &pb.VerifyRequest{Id: result.Id, Doc: &pb.Document{Data: documents[i]}, Sign: &pb.DocSign{Sign: result.GetSign(), KeyId: signs.KeyId}})
+				continue
+			}
+
+			assert.Equal(t, uint64(3), result.GetProof().TreeHead.TreeSize)
+			assert.Equal(t, result.GetProof(), signs.Proofs[i])
+			verification, err := client.VerifyInclusion(ctx, &pb.VerifyInclusionRequest{Doc: &pb.Document{Data: documents[i]}, Proof: result.GetProof()})
+			assert.NoError(t, err)
+			assert.True(t, verification.IsOk)
+		}
+
+		if merkleTree {
+			continue
+		}
+
+		verification, err := client.VerifyBatch(ctx, request)
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, true, true}, verification.Status)
+		for i, result := range verification.Results {
+			assert.Equal(t, request.Docs[i].Id, result.Id)
+		}
+	}
+
+	// Verification is bound by the same limits, invalid documents fail on their own.
+	sign, err := client.Sign(ctx, &pb.Document{Data: docs[0]})
+	assert.NoError(t, err)
+
+	verification, err := client.VerifyBatch(ctx, &pb.VerifyBatchRequest{Docs: []*pb.VerifyRequest{
+		{Id: "1", Doc: &pb.Document{Data: docs[0]}, Sign: sign},
+		{Id: "2", Doc: &pb.Document{Data: randData(t, 100)}, Sign: sign},
+		{Id: "3", Doc: &pb.Document{Data: docs[0]}},
+		{Id: "4", Doc: &pb.Document{Data: docs[0][1:]}, Sign: sign},
+		{Id: "5", Doc: &pb.Document{Data: docs[0]}, Sign: sign},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false, false, false}, verification.Status)
+
+	expected := []codes.Code{codes.OK, codes.ResourceExhausted, codes.InvalidArgument, codes.OK, codes.ResourceExhausted}
+	for i, result := range verification.Results {
+		assert.Equal(t, string(rune('1'+i)), result.Id)
+		assert.Equal(t, int32(expected[i]), result.GetError().GetCode(), i)
+	}
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_MISMATCH, verification.Results[3].Failure)
+
+	// A Merkle tree batch of rejected documents only has no tree.
+	signs, err := client.SignBatch(ctx, &pb.DocumentBatch{Doc: [][]byte{randData(t, 101)}, MerkleTree: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.ResourceExhausted), signs.Results[0].GetError().GetCode())
+}
@@ -0,0 +1,426 @@
+package internal
+
+import (
+	"bufio"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Serial numbers are 159 bit positive integers: at most 20 octets with the sign bit clear (RFC 5280 4.1.2.2).
+	_serialNumberBits = 159
+	// An attempt to draw a fresh serial number collides with probability 2^-159 per issued certificate.
+	_serialNumberAttempts = 8
+
+	_csrPemType = "CERTIFICATE REQUEST"
+)
+
+var (
+	ErrNoX509Algorithm           = errors.New("no X.509 signature algorithm")
+	ErrNoIssuerCertificate       = errors.New("no CA certificate")
+	ErrInvalidCertificateRequest = errors.New("invalid certificate request")
+	ErrSerialNumberExhausted     = errors.New("no unused serial number")
+)
+
+var _x509Algorithms = map[Algorithm]x509.SignatureAlgorithm{
+	AlgorithmEd25519:          x509.PureEd25519,
+	AlgorithmECDSAP256SHA256:  x509.ECDSAWithSHA256,
+	AlgorithmECDSAP384SHA384:  x509.ECDSAWithSHA384,
+	AlgorithmRSAPSS2048SHA256: x509.SHA256WithRSAPSS,
+	AlgorithmRSAPSS3072SHA256: x509.SHA256WithRSAPSS,
+	AlgorithmRSAPSS4096SHA256: x509.SHA256WithRSAPSS,
+}
+
+// X509SignatureAlgorithm returns the signature algorithm of certificates issued with the algorithm. ML-DSA and
+// hybrid keys have none.
+func (a Algorithm) X509SignatureAlgorithm() (x509.SignatureAlgorithm, error) {
+	if algorithm, ok := _x509Algorithms[a]; ok {
+		return algorithm, nil
+	}
+	return x509.UnknownSignatureAlgorithm, fmt.Errorf("%w: %s", ErrNoX509Algorithm, a)
+}
+
+// CertificateProfile is what the CA puts into every certificate it issues, whatever the request asks for.
+type CertificateProfile struct {
+	Validity    time.Duration
+	KeyUsage    x509.KeyUsage
+	ExtKeyUsage []x509.ExtKeyUsage
+	// PathLength issues CA certificates with the path length constraint when not negative, end entity
+	// certificates otherwise.
+	PathLength int
+}
+
+// DefaultCertificateProfile issues 90 day TLS server and client certificates.
+var DefaultCertificateProfile = CertificateProfile{
+	Validity:    90 * 24 * time.Hour,
+	KeyUsage:    x509.KeyUsageDigitalSignature,
+	ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	PathLength:  -1,
+}
+
+var _keyUsageNames = map[string]x509.KeyUsage{
+	"digitalSignature":  x509.KeyUsageDigitalSignature,
+	"contentCommitment": x509.KeyUsageContentCommitment,
+	"keyEncipherment":   x509.KeyUsageKeyEncipherment,
+	"dataEncipherment":  x509.KeyUsageDataEncipherment,
+	"keyAgreement":      x509.KeyUsageKeyAgreement,
+	"keyCertSign":       x509.KeyUsageCertSign,
+	"cRLSign":           x509.KeyUsageCRLSign,
+	"encipherOnly":      x509.KeyUsageEncipherOnly,
+	"decipherOnly":      x509.KeyUsageDecipherOnly,
+}
+
+var _extKeyUsageNames = map[string]x509.ExtKeyUsage{
+	"any":             x509.ExtKeyUsageAny,
+	"serverAuth":      x509.ExtKeyUsageServerAuth,
+	"clientAuth":      x509.ExtKeyUsageClientAuth,
+	"codeSigning":     x509.ExtKeyUsageCodeSigning,
+	"emailProtection": x509.ExtKeyUsageEmailProtection,
+	"timeStamping":    x509.ExtKeyUsageTimeStamping,
+	"OCSPSigning":     x509.ExtKeyUsageOCSPSigning,
+}
+
+// ParseKeyUsage parses a comma separated list of RFC 5280 key usage names, e.g. "digitalSignature,keyEncipherment".
+func ParseKeyUsage(names string) (x509.KeyUsage, error) {
+	var keyUsage x509.KeyUsage
+	for _, name := range splitNames(names) {
+		usage, ok := _keyUsageNames[name]
+		if !ok {
+			return 0, fmt.Errorf("unknown key usage %q", name)
+		}
+		keyUsage |= usage
+	}
+	return keyUsage, nil
+}
+
+// ParseExtKeyUsage parses a comma separated list of extended key usage names, e.g. "serverAuth,clientAuth".
+func ParseExtKeyUsage(names string) ([]x509.ExtKeyUsage, error) {
+	var extKeyUsage []x509.ExtKeyUsage
+	for _, name := range splitNames(names) {
+		usage, ok := _extKeyUsageNames[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown extended key usage %q", name)
+		}
+		extKeyUsage = append(extKeyUsage, usage)
+	}
+	return extKeyUsage, nil
+}
+
+func splitNames(names string) []string {
+	var split []string
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			split = append(split, name)
+		}
+	}
+	return split
+}
+
+// SerialRegistry records the serial numbers of issued certificates so none is used twice. A registry backed by a
+// file appends every serial number to it before the certificate is signed, so restarts keep the record.
+type SerialRegistry struct {
+	mu     sync.Mutex
+	issued map[string]bool
+	file   *os.File
+}
+
+// NewSerialRegistry returns a registry which keeps serial numbers in memory only.
+func NewSerialRegistry() *SerialRegistry {
+	return &SerialRegistry{issued: make(map[string]bool)}
+}
+
+// OpenSerialRegistry returns a registry backed by the file at path, one hex encoded serial number per line, which
+// is created when missing.
+func OpenSerialRegistry(path string) (*SerialRegistry, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open serial registry %s: %w", path, err)
+	}
+
+	registry := &SerialRegistry{issued: make(map[string]bool), file: file}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			registry.issued[strings.ToLower(fields[0])] = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("read serial registry %s: %w", path, err)
+	}
+	return registry, nil
+}
+
+// Reserve records serial and tells whether it was unused.
+func (r *SerialRegistry) Reserve(serial *big.Int) (bool, error) {
+	id := serial.Text(16)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.issued[id] {
+		return false, nil
+	}
+
+	if r.file != nil {
+		if _, err := fmt.Fprintf(r.file, "%s %s\n", id, time.Now().UTC().Format(time.RFC3339)); err != nil {
+			return false, err
+		}
+
+		if err := r.file.Sync(); err != nil {
+			return false, err
+		}
+	}
+
+	r.issued[id] = true
+	return true, nil
+}
+
+// Close closes the file of the registry.
+func (r *SerialRegistry) Close() error {
+	if r.file == nil {
+		return nil
+	}
+	return r.file.Close()
+}
+
+// CertificateAuthority issues certificates signed by an issuer key whose certificate is a CA certificate, see
+// Key.WithCertificates. The issuer key must sign nothing else: a key which signs documents signs a TBSCertificate of
+// the caller's choice as well.
+type CertificateAuthority struct {
+	issuer  *Key
+	profile CertificateProfile
+	serials *SerialRegistry
+}
+
+// NewCertificateAuthority returns a CA which issues certificates of profile with issuer. Without an issuer key every
+// certificate is refused with ErrNoIssuerCertificate.
+func NewCertificateAuthority(issuer *Key, profile CertificateProfile, serials *SerialRegistry) (*CertificateAuthority, error) {
+	if profile.Validity <= 0 {
+		return nil, fmt.Errorf("certificate validity %s is not positive", profile.Validity)
+	}
+
+	if profile.PathLength >= 0 {
+		profile.KeyUsage |= x509.KeyUsageCertSign
+	}
+	return &CertificateAuthority{issuer: issuer, profile: profile, serials: serials}, nil
+}
+
+// CertificateTemplate is the subject of a certificate to issue.
+type CertificateTemplate struct {
+	Subject        pkix.Name
+	DNSNames       []string
+	EmailAddresses []string
+	IPAddresses    []net.IP
+	URIs           []*url.URL
+}
+
+// ParseCertificateRequest decodes a PEM or DER encoded PKCS #10 request and checks its signature.
+func ParseCertificateRequest(data []byte) (*x509.CertificateRequest, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != _csrPemType {
+			return nil, fmt.Errorf("%w: %v: %s", ErrInvalidCertificateRequest, ErrUnexpectedPem, block.Type)
+		}
+		data = block.Bytes
+	}
+
+	request, err := x509.ParseCertificateRequest(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificateRequest, err)
+	}
+
+	if err := request.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificateRequest, err)
+	}
+	return request, nil
+}
+
+// ParseSubjectPublicKey decodes a PEM or DER encoded SubjectPublicKeyInfo of a key to certify.
+func ParseSubjectPublicKey(data []byte) (crypto.PublicKey, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != _publicKeyPemType {
+			return nil, fmt.Errorf("%w: %v: %s", ErrInvalidCertificateRequest, ErrUnexpectedPem, block.Type)
+		}
+		data = block.Bytes
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificateRequest, err)
+	}
+	return publicKey, nil
+}
+
+// SignCSR issues a certificate for the key and the subject of a PKCS #10 request. Extensions the request asks for
+// are ignored, the profile decides.
+func (ca *CertificateAuthority) SignCSR(request *x509.CertificateRequest) (*x509.Certificate, error) {
+	return ca.Issue(request.PublicKey, CertificateTemplate{
+		Subject:        request.Subject,
+		DNSNames:       request.DNSNames,
+		EmailAddresses: request.EmailAddresses,
+		IPAddresses:    request.IPAddresses,
+		URIs:           request.URIs,
+	})
+}
+
+// Issue issues a certificate for publicKey, an Ed25519, ECDSA or RSA key, with a fresh serial number. It expires
+// with the CA certificate at the latest.
+func (ca *CertificateAuthority) Issue(publicKey crypto.PublicKey, template CertificateTemplate) (*x509.Certificate, error) {
+	switch publicKey.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey, *rsa.PublicKey:
+	default:
+		return nil, fmt.Errorf("%w: unsupported subject key %T", ErrInvalidCertificateRequest, publicKey)
+	}
+
+	if len(template.Subject.ToRDNSequence()) == 0 && len(template.DNSNames)+len(template.EmailAddresses)+
+		len(template.IPAddresses)+len(template.URIs) == 0 {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidCertificateRequest)
+	}
+
+	parent, err := ca.issuerCertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := ca.issuer
+	signatureAlgorithm, err := issuer.Algorithm.X509SignatureAlgorithm()
+	if err != nil {
+		return nil, err
+	}
+
+	if issuer.Signer == nil {
+		return nil, fmt.Errorf("key %s is %s", issuer.ID, issuer.Status)
+	}
+
+	subjectKeyID, err := x509SubjectKeyID(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := ca.serialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	notBefore := time.Now().UTC().Truncate(time.Second)
+	notAfter := notBefore.Add(ca.profile.Validity)
+	if notAfter.After(parent.NotAfter) {
+		notAfter = parent.NotAfter
+	}
+
+	certificate := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               template.Subject,
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              ca.profile.KeyUsage,
+		ExtKeyUsage:           ca.profile.ExtKeyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  ca.profile.PathLength >= 0,
+		MaxPathLen:            ca.profile.PathLength,
+		MaxPathLenZero:        ca.profile.PathLength == 0,
+		SubjectKeyId:          subjectKeyID,
+		DNSNames:              template.DNSNames,
+		EmailAddresses:        template.EmailAddresses,
+		IPAddresses:           template.IPAddresses,
+		URIs:                  template.URIs,
+		SignatureAlgorithm:    signatureAlgorithm,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, certificate, parent, publicKey, issuer.Signer)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// issuerCertificate returns the certificate of the issuer key if it may issue certificates of the profile.
+func (ca *CertificateAuthority) issuerCertificate() (*x509.Certificate, error) {
+	issuer := ca.issuer
+	if issuer == nil {
+		return nil, fmt.Errorf("%w: no CA key", ErrNoIssuerCertificate)
+	}
+
+	if len(issuer.Certificates) == 0 {
+		return nil, fmt.Errorf("%w: key %s has no certificate", ErrNoIssuerCertificate, issuer.ID)
+	}
+
+	parent := issuer.Certificates[0]
+	if !parent.BasicConstraintsValid || !parent.IsCA ||
+		(parent.KeyUsage != 0 && parent.KeyUsage&x509.KeyUsageCertSign == 0) {
+		return nil, fmt.Errorf("%w: certificate of key %s is not a CA certificate", ErrNoIssuerCertificate, issuer.ID)
+	}
+
+	// A CA certificate must leave room below itself for the CA certificates it issues.
+	if ca.profile.PathLength >= 0 && parent.MaxPathLen >= 0 && ca.profile.PathLength >= parent.MaxPathLen {
+		return nil, fmt.Errorf("%w: path length %d of the CA certificate does not allow CA certificates with path length %d",
+			ErrNoIssuerCertificate, parent.MaxPathLen, ca.profile.PathLength)
+	}
+
+	if time.Now().After(parent.NotAfter) {
+		return nil, fmt.Errorf("%w: CA certificate expired at %s", ErrNoIssuerCertificate, parent.NotAfter)
+	}
+	return parent, nil
+}
+
+// serialNumber draws a random serial number and records it.
+func (ca *CertificateAuthority) serialNumber() (*big.Int, error) {
+	limit := new(big.Int).Lsh(big.NewInt(1), _serialNumberBits)
+	for i := 0; i < _serialNumberAttempts; i++ {
+		serial, err := rand.Int(rand.Reader, limit)
+		if err != nil {
+			return nil, err
+		}
+
+		if serial.Sign() == 0 {
+			continue
+		}
+
+		unused, err := ca.serials.Reserve(serial)
+		if err != nil {
+			return nil, fmt.Errorf("record serial number: %w", err)
+		}
+
+		if unused {
+			return serial, nil
+		}
+	}
+	return nil, ErrSerialNumberExhausted
+}
+
+// x509SubjectKeyID is the SHA-1 digest of the subject public key bit string (RFC 5280 4.2.1.2, method 1).
+func x509SubjectKeyID(publicKey crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(der, &spki); err != nil {
+		return nil, err
+	}
+
+	digest := sha1.Sum(spki.PublicKey.Bytes)
+	return digest[:], nil
+}
@@ -0,0 +1,253 @@
+package internal
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCAKey returns a key with a self-signed CA certificate valid for validity.
+func newTestCAKey(t *testing.T, algorithm Algorithm, pathLength int, validity time.Duration) *Key {
+	key := newTestAlgorithmKey(t, algorithm)
+
+	signatureAlgorithm, err := algorithm.X509SignatureAlgorithm()
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "docsign test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            pathLength,
+		MaxPathLenZero:        pathLength == 0,
+		SignatureAlgorithm:    signatureAlgorithm,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.PublicKey, key.Signer)
+	assert.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	key, err = key.WithCertificates([]*x509.Certificate{certificate})
+	assert.NoError(t, err)
+	return key
+}
+
+func newTestCertificateRequest(t *testing.T, template *x509.CertificateRequest) []byte {
+	subjectKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, subjectKey)
+	assert.NoError(t, err)
+	return der
+}
+
+func TestCertificateAuthority_SignCSR(t *testing.T) {
+	t.Parallel()
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			issuer := newTestCAKey(t, algorithm, -1, 24*time.Hour)
+
+			ca, err := NewCertificateAuthority(issuer, DefaultCertificateProfile, NewSerialRegistry())
+			assert.NoError(t, err)
+
+			der := newTestCertificateRequest(t, &x509.CertificateRequest{
+				Subject:     pkix.Name{CommonName: "service.example.com"},
+				DNSNames:    []string{"service.example.com"},
+				IPAddresses: []net.IP{net.ParseIP("10.0.0.1")},
+			})
+			request, err := ParseCertificateRequest(pem.EncodeToMemory(&pem.Block{Type: _csrPemType, Bytes: der}))
+			assert.NoError(t, err)
+
+			certificate, err := ca.SignCSR(request)
+			assert.NoError(t, err)
+
+			roots := x509.NewCertPool()
+			roots.AddCert(issuer.Certificates[0])
+			_, err = certificate.Verify(x509.VerifyOptions{Roots: roots, DNSName: "service.example.com"})
+			assert.NoError(t, err)
+
+			assert.Equal(t, "service.example.com", certificate.Subject.CommonName)
+			assert.True(t, certificate.IPAddresses[0].Equal(net.ParseIP("10.0.0.1")))
+			assert.Equal(t, x509.KeyUsageDigitalSignature, certificate.KeyUsage)
+			assert.Equal(t, DefaultCertificateProfile.ExtKeyUsage, certificate.ExtKeyUsage)
+			assert.False(t, certificate.IsCA)
+			assert.NotEmpty(t, certificate.SubjectKeyId)
+
+			// The certificate expires with the CA certificate.
+			assert.Equal(t, issuer.Certificates[0].NotAfter, certificate.NotAfter)
+		})
+	}
+}
+
+func TestCertificateAuthority_Issue(t *testing.T) {
+	t.Parallel()
+
+	subjectKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := CertificateTemplate{Subject: pkix.Name{CommonName: "intermediate"}}
+	profile := CertificateProfile{Validity: time.Hour, PathLength: 0}
+
+	ca, err := NewCertificateAuthority(newTestCAKey(t, AlgorithmEd25519, 1, 24*time.Hour), profile, NewSerialRegistry())
+	assert.NoError(t, err)
+
+	certificate, err := ca.Issue(&subjectKey.PublicKey, template)
+	assert.NoError(t, err)
+	assert.True(t, certificate.IsCA)
+	assert.True(t, certificate.MaxPathLenZero)
+	assert.Equal(t, x509.KeyUsageCertSign, certificate.KeyUsage)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), certificate.NotAfter, time.Minute)
+
+	tests := []struct {
+		name     string
+		issuer   *Key
+		template CertificateTemplate
+		err      error
+	}{
+		{name: "Case #1", issuer: newTestKey(t), template: template, err: ErrNoIssuerCertificate},
+		{name: "Case #2", issuer: newTestCertifiedKey(t, AlgorithmEd25519), template: template, err: ErrNoIssuerCertificate},
+		{name: "Case #3", issuer: newTestCAKey(t, AlgorithmEd25519, 0, 24*time.Hour), template: template, err: ErrNoIssuerCertificate},
+		{name: "Case #4", issuer: newTestCAKey(t, AlgorithmEd25519, 1, -time.Minute), template: template, err: ErrNoIssuerCertificate},
+		{name: "Case #5", issuer: newTestCAKey(t, AlgorithmEd25519, 1, 24*time.Hour), template: CertificateTemplate{}, err: ErrInvalidCertificateRequest},
+		{name: "Case #6", issuer: nil, template: template, err: ErrNoIssuerCertificate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca, err := NewCertificateAuthority(tt.issuer, profile, NewSerialRegistry())
+			assert.NoError(t, err)
+
+			_, err = ca.Issue(&subjectKey.PublicKey, tt.template)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	mldsa := newTestAlgorithmKey(t, AlgorithmMLDSA44)
+	_, err = mldsa.Algorithm.X509SignatureAlgorithm()
+	assert.ErrorIs(t, err, ErrNoX509Algorithm)
+
+	ca, err = NewCertificateAuthority(newTestCAKey(t, AlgorithmEd25519, 1, 24*time.Hour).retire(), profile, NewSerialRegistry())
+	assert.NoError(t, err)
+	_, err = ca.Issue(&subjectKey.PublicKey, template)
+	assert.Error(t, err)
+
+	_, err = NewCertificateAuthority(nil, CertificateProfile{}, NewSerialRegistry())
+	assert.Error(t, err)
+}
+
+func TestSerialRegistry(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "serials")
+
+	registry, err := OpenSerialRegistry(path)
+	assert.NoError(t, err)
+
+	ca, err := NewCertificateAuthority(newTestCAKey(t, AlgorithmEd25519, -1, 24*time.Hour), DefaultCertificateProfile, registry)
+	assert.NoError(t, err)
+
+	subjectKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	serials := map[string]bool{}
+	for i := 0; i < 10; i++ {
+		certificate, err := ca.Issue(&subjectKey.PublicKey, CertificateTemplate{DNSNames: []string{"example.com"}})
+		assert.NoError(t, err)
+		assert.Positive(t, certificate.SerialNumber.Sign())
+		assert.LessOrEqual(t, certificate.SerialNumber.BitLen(), _serialNumberBits)
+		serials[certificate.SerialNumber.Text(16)] = true
+
+		unused, err := registry.Reserve(certificate.SerialNumber)
+		assert.NoError(t, err)
+		assert.False(t, unused)
+	}
+	assert.Len(t, serials, 10)
+	assert.NoError(t, registry.Close())
+
+	// The serial numbers survive a restart.
+	registry, err = OpenSerialRegistry(path)
+	assert.NoError(t, err)
+	defer registry.Close()
+
+	for serial := range serials {
+		number, ok := new(big.Int).SetString(serial, 16)
+		assert.True(t, ok)
+
+		unused, err := registry.Reserve(number)
+		assert.NoError(t, err)
+		assert.False(t, unused)
+	}
+
+	unused, err := registry.Reserve(big.NewInt(1))
+	assert.NoError(t, err)
+	assert.True(t, unused)
+}
+
+func TestParseKeyUsage(t *testing.T) {
+	t.Parallel()
+
+	keyUsage, err := ParseKeyUsage("digitalSignature, keyEncipherment")
+	assert.NoError(t, err)
+	assert.Equal(t, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment, keyUsage)
+
+	keyUsage, err = ParseKeyUsage("")
+	assert.NoError(t, err)
+	assert.Zero(t, keyUsage)
+
+	_, err = ParseKeyUsage("digitalSignature,signing")
+	assert.Error(t, err)
+
+	extKeyUsage, err := ParseExtKeyUsage("codeSigning,timeStamping")
+	assert.NoError(t, err)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning, x509.ExtKeyUsageTimeStamping}, extKeyUsage)
+
+	_, err = ParseExtKeyUsage("serverAuth,tls")
+	assert.Error(t, err)
+}
+
+func TestParseCertificateRequest(t *testing.T) {
+	t.Parallel()
+
+	der := newTestCertificateRequest(t, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "example.com"}})
+
+	tampered := append([]byte(nil), der...)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name       string
+		data       []byte
+		shouldFail bool
+	}{
+		{name: "Case #1", data: der, shouldFail: false},
+		{name: "Case #2", data: pem.EncodeToMemory(&pem.Block{Type: _csrPemType, Bytes: der}), shouldFail: false},
+		{name: "Case #3", data: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), shouldFail: true},
+		{name: "Case #4", data: tampered, shouldFail: true},
+		{name: "Case #5", data: nil, shouldFail: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCertificateRequest(tt.data)
+			if tt.shouldFail {
+				assert.ErrorIs(t, err, ErrInvalidCertificateRequest)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,439 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+var (
+	_oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	_oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	_oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	_oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+	_oidSigningTime   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 5}
+
+	_oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	_oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	_oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	_oidEd25519         = asn1.ObjectIdentifier{1, 3, 101, 112}
+	_oidECDSAWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}
+	_oidECDSAWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 3}
+	_oidRSASSAPSS       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+	_oidMGF1            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 8}
+)
+
+var (
+	ErrMalformedCMS   = errors.New("malformed CMS SignedData")
+	ErrNoCMSAlgorithm = errors.New("no CMS algorithm")
+	ErrNoCertificate  = errors.New("no certificate")
+)
+
+var _cmsDigestOIDs = map[crypto.Hash]asn1.ObjectIdentifier{
+	crypto.SHA256: _oidSHA256,
+	crypto.SHA384: _oidSHA384,
+	crypto.SHA512: _oidSHA512,
+}
+
+// cmsAlgorithm is the message digest and the signature algorithm of a SignerInfo.
+type cmsAlgorithm struct {
+	hash      crypto.Hash
+	signature pkix.AlgorithmIdentifier
+}
+
+type pssParameters struct {
+	Hash       pkix.AlgorithmIdentifier `asn1:"explicit,tag:0"`
+	MGF        pkix.AlgorithmIdentifier `asn1:"explicit,tag:1"`
+	SaltLength int                      `asn1:"explicit,tag:2"`
+}
+
+// _cmsPSSParameters are the RSASSA-PSS-params of PS256: SHA-256, MGF1 with SHA-256 and a 32 byte salt.
+var _cmsPSSParameters = func() asn1.RawValue {
+	sha256 := pkix.AlgorithmIdentifier{Algorithm: _oidSHA256, Parameters: asn1.NullRawValue}
+	mgfHash, _ := asn1.Marshal(sha256)
+	encoded, _ := asn1.Marshal(pssParameters{
+		Hash:       sha256,
+		MGF:        pkix.AlgorithmIdentifier{Algorithm: _oidMGF1, Parameters: asn1.RawValue{FullBytes: mgfHash}},
+		SaltLength: 32,
+	})
+	return asn1.RawValue{FullBytes: encoded}
+}()
+
+// cmsAlgorithm returns the digest and signature algorithms of SignerInfos made with the algorithm. Ed25519 follows
+// RFC 8419 and ML-DSA RFC 9882, both with SHA-512 message digests.
+func (a Algorithm) cmsAlgorithm() (cmsAlgorithm, error) {
+	switch a {
+	case AlgorithmEd25519:
+		return cmsAlgorithm{hash: crypto.SHA512, signature: pkix.AlgorithmIdentifier{Algorithm: _oidEd25519}}, nil
+	case AlgorithmECDSAP256SHA256:
+		return cmsAlgorithm{hash: crypto.SHA256, signature: pkix.AlgorithmIdentifier{Algorithm: _oidECDSAWithSHA256}}, nil
+	case AlgorithmECDSAP384SHA384:
+		return cmsAlgorithm{hash: crypto.SHA384, signature: pkix.AlgorithmIdentifier{Algorithm: _oidECDSAWithSHA384}}, nil
+	case AlgorithmRSAPSS2048SHA256, AlgorithmRSAPSS3072SHA256, AlgorithmRSAPSS4096SHA256:
+		return cmsAlgorithm{hash: crypto.SHA256, signature: pkix.AlgorithmIdentifier{Algorithm: _oidRSASSAPSS, Parameters: _cmsPSSParameters}}, nil
+	case AlgorithmMLDSA44, AlgorithmMLDSA65, AlgorithmMLDSA87:
+		return cmsAlgorithm{hash: crypto.SHA512, signature: pkix.AlgorithmIdentifier{Algorithm: _mldsaOIDs[a]}}, nil
+	default:
+		return cmsAlgorithm{}, fmt.Errorf("%w: %s", ErrNoCMSAlgorithm, a)
+	}
+}
+
+// cmsContentInfo holds the content in an explicit [0] tag, asn1.RawValue leaves tagging to the value.
+type cmsContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0"`
+}
+
+type cmsSignedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo cmsEncapsulatedContentInfo
+	Certificates     asn1.RawValue   `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue   `asn1:"optional,tag:1"`
+	SignerInfos      []cmsSignerInfo `asn1:"set"`
+}
+
+type cmsEncapsulatedContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"optional,explicit,tag:0"`
+}
+
+type cmsSignerInfo struct {
+	Version            int
+	SID                cmsIssuerAndSerialNumber
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type cmsIssuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type cmsAttribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue
+}
+
+// CMSOptions are the optional parts of a CMS SignedData.
+type CMSOptions struct {
+	// Detached leaves the payload out of the SignedData.
+	Detached bool
+	// SigningTime is the signing-time attribute, the current time when zero.
+	SigningTime time.Time
+}
+
+// SignCMS signs payload with key and returns a DER encoded ContentInfo holding a CMS SignedData (RFC 5652). The only
+// SignerInfo identifies the signer by the issuer and serial number of the first certificate of the key and carries
+// content-type, message-digest and signing-time signed attributes. Every certificate of the key is embedded.
+func SignCMS(key *Key, payload []byte, options CMSOptions) ([]byte, error) {
+	signingTime := options.SigningTime
+	if signingTime.IsZero() {
+		signingTime = time.Now()
+	}
+
+	return cmsSign(key, _oidData, payload, options.Detached, key.Certificates, []cmsSignedAttribute{
+		{_oidSigningTime, signingTime.UTC().Truncate(time.Second)},
+	})
+}
+
+// cmsSignedAttribute is a signed attribute with a single value.
+type cmsSignedAttribute struct {
+	oid   asn1.ObjectIdentifier
+	value interface{}
+}
+
+// cmsSign returns a ContentInfo holding a SignedData of content made by key, see SignCMS. The content-type and
+// message-digest attributes precede attributes, certificates are embedded.
+func cmsSign(key *Key, contentType asn1.ObjectIdentifier, content []byte, detached bool,
+	certificates []*x509.Certificate, attributes []cmsSignedAttribute,
+) ([]byte, error) {
+	if len(key.Certificates) == 0 {
+		return nil, fmt.Errorf("%w: key %s", ErrNoCertificate, key.ID)
+	}
+
+	algorithm, err := key.Algorithm.cmsAlgorithm()
+	if err != nil {
+		return nil, err
+	}
+
+	digest := algorithm.hash.New()
+	digest.Write(content)
+
+	signedAttrs, err := cmsSignedAttributes(append([]cmsSignedAttribute{
+		{_oidContentType, contentType},
+		{_oidMessageDigest, digest.Sum(nil)},
+	}, attributes...))
+	if err != nil {
+		return nil, err
+	}
+
+	toBeSigned, err := cmsSignedAttributesSet(signedAttrs)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := key.Sign(toBeSigned)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawCertificates []byte
+	for _, certificate := range certificates {
+		rawCertificates = append(rawCertificates, certificate.Raw...)
+	}
+
+	digestAlgorithm := pkix.AlgorithmIdentifier{Algorithm: _cmsDigestOIDs[algorithm.hash]}
+	signedData := cmsSignedData{
+		Version:          1,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{digestAlgorithm},
+		EncapContentInfo: cmsEncapsulatedContentInfo{EContentType: contentType},
+		SignerInfos: []cmsSignerInfo{{
+			Version: 1,
+			SID: cmsIssuerAndSerialNumber{
+				Issuer:       asn1.RawValue{FullBytes: key.Certificates[0].RawIssuer},
+				SerialNumber: key.Certificates[0].SerialNumber,
+			},
+			DigestAlgorithm:    digestAlgorithm,
+			SignedAttrs:        asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedAttrs},
+			SignatureAlgorithm: algorithm.signature,
+			Signature:          signature,
+		}},
+	}
+	if len(rawCertificates) > 0 {
+		signedData.Certificates = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: rawCertificates}
+	}
+	if !detached {
+		signedData.EncapContentInfo.EContent = nonNil(content)
+	}
+
+	encoded, err := asn1.Marshal(signedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(cmsContentInfo{ContentType: _oidSignedData, Content: asn1.RawValue{
+		Class:      asn1.ClassContextSpecific,
+		Tag:        0,
+		IsCompound: true,
+		Bytes:      encoded,
+	}})
+}
+
+// CMSVerification is the result of a successful VerifyCMS.
+type CMSVerification struct {
+	Key         *Key
+	Payload     []byte
+	SigningTime time.Time
+}
+
+// VerifyCMS checks a DER encoded SignedData made by SignCMS against the keys of keyring. detachedPayload is used
+// when the SignedData carries no content. The embedded certificates only point to the key: the signer certificate
+// must hold the public key of an active or retired key, its chain is not validated.
+func VerifyCMS(keyring *Keyring, der, detachedPayload []byte) (*CMSVerification, error) {
+	signedData, err := cmsParseSignedData(der)
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &CMSVerification{Payload: signedData.EncapContentInfo.EContent}
+	if verification.Payload == nil {
+		verification.Payload = detachedPayload
+	}
+
+	key, attributes, err := cmsVerifySigner(keyring, signedData, verification.Payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	verification.Key = key
+
+	if err := cmsAttributeValue(attributes, _oidSigningTime, &verification.SigningTime); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+var errCMSMismatch = errors.New("CMS signature mismatch")
+
+// cmsParseSignedData decodes a ContentInfo holding a SignedData with a single SignerInfo.
+func cmsParseSignedData(der []byte) (*cmsSignedData, error) {
+	var contentInfo cmsContentInfo
+	if rest, err := asn1.Unmarshal(der, &contentInfo); err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: content info: %v", ErrMalformedCMS, err)
+	}
+
+	if !contentInfo.ContentType.Equal(_oidSignedData) {
+		return nil, fmt.Errorf("%w: content type %s", ErrMalformedCMS, contentInfo.ContentType)
+	}
+
+	var signedData cmsSignedData
+	if rest, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: signed data: %v", ErrMalformedCMS, err)
+	}
+
+	if len(signedData.SignerInfos) != 1 {
+		return nil, fmt.Errorf("%w: %d signer infos", ErrMalformedCMS, len(signedData.SignerInfos))
+	}
+	return &signedData, nil
+}
+
+// cmsVerifySigner checks the SignerInfo of signedData over payload and returns its key and signed attributes. The
+// signer certificate is looked for among the embedded certificates and then among certificates.
+func cmsVerifySigner(keyring *Keyring, signedData *cmsSignedData, payload []byte, certificates []*x509.Certificate,
+) (*Key, map[string][]byte, error) {
+	signer := signedData.SignerInfos[0]
+
+	embedded, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: certificates: %v", ErrMalformedCMS, err)
+	}
+
+	key, err := cmsSignerKey(keyring, append(embedded, certificates...), signer.SID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	algorithm, err := key.Algorithm.cmsAlgorithm()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !signer.DigestAlgorithm.Algorithm.Equal(_cmsDigestOIDs[algorithm.hash]) ||
+		!signer.SignatureAlgorithm.Algorithm.Equal(algorithm.signature.Algorithm) {
+		return nil, nil, fmt.Errorf("%w: algorithms %s, %s for a %s key", errCMSMismatch,
+			signer.DigestAlgorithm.Algorithm, signer.SignatureAlgorithm.Algorithm, key.Algorithm)
+	}
+
+	attributes, err := cmsParseAttributes(signer.SignedAttrs.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var contentType asn1.ObjectIdentifier
+	if err := cmsAttributeValue(attributes, _oidContentType, &contentType); err != nil {
+		return nil, nil, err
+	}
+
+	if !contentType.Equal(signedData.EncapContentInfo.EContentType) {
+		return nil, nil, fmt.Errorf("%w: content type attribute %s", ErrMalformedCMS, contentType)
+	}
+
+	var messageDigest []byte
+	if err := cmsAttributeValue(attributes, _oidMessageDigest, &messageDigest); err != nil {
+		return nil, nil, err
+	}
+
+	digest := algorithm.hash.New()
+	digest.Write(payload)
+	if !bytes.Equal(digest.Sum(nil), messageDigest) {
+		return nil, nil, fmt.Errorf("%w: message digest", errCMSMismatch)
+	}
+
+	signed, err := cmsSignedAttributesSet(signer.SignedAttrs.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !key.Verify(signed, signer.Signature) {
+		return nil, nil, errCMSMismatch
+	}
+	return key, attributes, nil
+}
+
+// cmsSignedAttributes encodes attributes in DER SET OF order.
+func cmsSignedAttributes(attributes []cmsSignedAttribute) ([]byte, error) {
+	encoded := make([][]byte, 0, len(attributes))
+	for _, a := range attributes {
+		value, err := asn1.Marshal(a.value)
+		if err != nil {
+			return nil, err
+		}
+
+		attribute, err := asn1.Marshal(cmsAttribute{
+			Type:   a.oid,
+			Values: asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: value},
+		})
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, attribute)
+	}
+
+	sort.Slice(encoded, func(i, j int) bool {
+		return bytes.Compare(encoded[i], encoded[j]) < 0
+	})
+	return bytes.Join(encoded, nil), nil
+}
+
+// cmsSignedAttributesSet is the signature input of signed attributes: their DER encoding with a SET tag instead of
+// the implicit [0] tag of the SignerInfo field.
+func cmsSignedAttributesSet(attributes []byte) ([]byte, error) {
+	return asn1.Marshal(asn1.RawValue{Tag: asn1.TagSet, IsCompound: true, Bytes: attributes})
+}
+
+func cmsParseAttributes(encoded []byte) (map[string][]byte, error) {
+	attributes := map[string][]byte{}
+	for rest := encoded; len(rest) > 0; {
+		var (
+			attribute cmsAttribute
+			err       error
+		)
+		if rest, err = asn1.Unmarshal(rest, &attribute); err != nil {
+			return nil, fmt.Errorf("%w: signed attributes: %v", ErrMalformedCMS, err)
+		}
+
+		oid := attribute.Type.String()
+		if _, ok := attributes[oid]; ok || attribute.Values.Tag != asn1.TagSet {
+			return nil, fmt.Errorf("%w: signed attribute %s", ErrMalformedCMS, oid)
+		}
+		attributes[oid] = attribute.Values.Bytes
+	}
+	return attributes, nil
+}
+
+// cmsAttributeValue decodes the single value of a required attribute.
+func cmsAttributeValue(attributes map[string][]byte, oid asn1.ObjectIdentifier, value interface{}) error {
+	encoded, ok := attributes[oid.String()]
+	if !ok {
+		return fmt.Errorf("%w: no signed attribute %s", ErrMalformedCMS, oid)
+	}
+
+	if rest, err := asn1.Unmarshal(encoded, value); err != nil || len(rest) != 0 {
+		return fmt.Errorf("%w: signed attribute %s: %v", ErrMalformedCMS, oid, err)
+	}
+	return nil
+}
+
+// cmsSignerKey finds the certificate of a signer and the key of its public key.
+func cmsSignerKey(keyring *Keyring, certificates []*x509.Certificate, sid cmsIssuerAndSerialNumber) (*Key, error) {
+	for _, certificate := range certificates {
+		if sid.SerialNumber == nil || certificate.SerialNumber.Cmp(sid.SerialNumber) != 0 ||
+			!bytes.Equal(certificate.RawIssuer, sid.Issuer.FullBytes) {
+			continue
+		}
+
+		publicKey, err := parsePKIXPublicKey(certificate.RawSubjectPublicKeyInfo)
+		if err != nil {
+			return nil, fmt.Errorf("%w: signer certificate: %v", ErrMalformedCMS, err)
+		}
+
+		id := KeyID(publicKey)
+		key, ok := keyring.Lookup(id)
+		if id == "" || !ok {
+			return nil, fmt.Errorf("%w: %w %q", errCMSMismatch, ErrUnknownKey, id)
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: no signer certificate", ErrMalformedCMS)
+}
@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCertificates issues a certificate of publicKey with the given extended key usages, marked critical, by a new
+// CA and returns it followed by the CA certificate.
+func newTestCertificates(t *testing.T, publicKey crypto.PublicKey, extKeyUsage ...x509.ExtKeyUsage) []*x509.Certificate {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "docsign test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, caKey.Public(), caKey)
+	assert.NoError(t, err)
+	ca, err = x509.ParseCertificate(caDER)
+	assert.NoError(t, err)
+
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "docsign"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  extKeyUsage,
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, ca, publicKey, caKey)
+	assert.NoError(t, err)
+
+	// x509.CreateCertificate never marks extended key usages critical, the certificate is issued again with its
+	// extension as a critical extra extension.
+	if len(extKeyUsage) != 0 {
+		issued, err := x509.ParseCertificate(leafDER)
+		assert.NoError(t, err)
+		for _, extension := range issued.Extensions {
+			if extension.Id.Equal(_oidExtKeyUsage) {
+				extension.Critical = true
+				leaf.ExtraExtensions = append(leaf.ExtraExtensions, extension)
+			}
+		}
+
+		leafDER, err = x509.CreateCertificate(rand.Reader, leaf, ca, publicKey, caKey)
+		assert.NoError(t, err)
+	}
+
+	leaf, err = x509.ParseCertificate(leafDER)
+	assert.NoError(t, err)
+
+	return []*x509.Certificate{leaf, ca}
+}
+
+func newTestCertifiedKey(t *testing.T, algorithm Algorithm) *Key {
+	key := newTestAlgorithmKey(t, algorithm)
+	key, err := key.WithCertificates(newTestCertificates(t, key.PublicKey))
+	assert.NoError(t, err)
+	return key
+}
+
+func TestSignCMS(t *testing.T) {
+	t.Parallel()
+
+	signingTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	payload := randData(t, 1000)
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256} {
+		key := newTestCertifiedKey(t, algorithm)
+		keyring := NewKeyring(key)
+
+		for _, detached := range []bool{false, true} {
+			t.Run(algorithm.String(), func(t *testing.T) {
+				signedData, err := SignCMS(key, payload, CMSOptions{Detached: detached, SigningTime: signingTime.Add(time.Millisecond)})
+				assert.NoError(t, err)
+
+				var content []byte
+				if detached {
+					content = payload
+				}
+
+				verification, err := VerifyCMS(keyring, signedData, content)
+				assert.NoError(t, err)
+				assert.Equal(t, key.ID, verification.Key.ID)
+				assert.Equal(t, payload, verification.Payload)
+				assert.True(t, signingTime.Equal(verification.SigningTime))
+
+				_, err = VerifyCMS(keyring, signedData, payload[1:])
+				assert.Equal(t, detached, err != nil)
+
+				// Still valid once the key is retired.
+				_, err = VerifyCMS(NewKeyring(newTestKey(t), key.retire()), signedData, content)
+				assert.NoError(t, err)
+
+				_, err = VerifyCMS(NewKeyring(newTestKey(t)), signedData, content)
+				assert.Error(t, err)
+
+				tampered := append([]byte(nil), signedData...)
+				tampered[len(tampered)-1] ^= 1
+				_, err = VerifyCMS(keyring, tampered, content)
+				assert.Error(t, err)
+			})
+		}
+	}
+
+	_, err := SignCMS(newTestKey(t), payload, CMSOptions{})
+	assert.ErrorIs(t, err, ErrNoCertificate)
+
+	hybrid := newTestAlgorithmKey(t, AlgorithmEd25519MLDSA65)
+	hybrid.Certificates = newTestCertificates(t, newTestKey(t).PublicKey)
+	_, err = SignCMS(hybrid, payload, CMSOptions{})
+	assert.ErrorIs(t, err, ErrNoCMSAlgorithm)
+}
+
+func TestVerifyCMS_Malformed(t *testing.T) {
+	t.Parallel()
+
+	key := newTestCertifiedKey(t, AlgorithmEd25519)
+	signedData, err := SignCMS(key, []byte("payload"), CMSOptions{})
+	assert.NoError(t, err)
+
+	tests := map[string][]byte{
+		"Case #1": nil,
+		"Case #2": signedData[:len(signedData)-1],
+		"Case #3": append(append([]byte(nil), signedData...), 0),
+		"Case #4": []byte{0x30, 0x00},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			_, err := VerifyCMS(NewKeyring(key), tt, nil)
+			assert.ErrorIs(t, err, ErrMalformedCMS)
+		})
+	}
+}
+
+func TestKey_WithCertificates(t *testing.T) {
+	t.Parallel()
+
+	key := newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256)
+	certificates := newTestCertificates(t, key.PublicKey)
+
+	certified, err := key.WithCertificates(certificates)
+	assert.NoError(t, err)
+	assert.Equal(t, certificates, certified.Certificates)
+	assert.Empty(t, key.Certificates)
+
+	_, err = key.WithCertificates(certificates[1:])
+	assert.ErrorIs(t, err, ErrCertificateMismatch)
+
+	_, err = key.WithCertificates(nil)
+	assert.ErrorIs(t, err, ErrNoCertificate)
+}
@@ -0,0 +1,335 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// COSE header labels and message tags (RFC 9052).
+const (
+	_coseHeaderAlgorithm   = 1
+	_coseHeaderCritical    = 2
+	_coseHeaderContentType = 3
+	_coseHeaderKeyID       = 4
+
+	_coseSign1Tag = 18
+	_coseSignTag  = 98
+)
+
+var (
+	ErrMalformedCOSE   = errors.New("malformed COSE message")
+	ErrNoCOSEAlgorithm = errors.New("no COSE algorithm")
+)
+
+// COSE algorithm identifiers of RFC 9053, RFC 8230 and the ML-DSA for COSE draft.
+var _coseAlgorithms = map[Algorithm]int64{
+	AlgorithmEd25519:          -8,
+	AlgorithmECDSAP256SHA256:  -7,
+	AlgorithmECDSAP384SHA384:  -35,
+	AlgorithmRSAPSS2048SHA256: -37,
+	AlgorithmRSAPSS3072SHA256: -37,
+	AlgorithmRSAPSS4096SHA256: -37,
+	AlgorithmMLDSA44:          -48,
+	AlgorithmMLDSA65:          -49,
+	AlgorithmMLDSA87:          -50,
+}
+
+// COSEAlgorithm returns the "alg" header value of COSE signatures made with the algorithm.
+func (a Algorithm) COSEAlgorithm() (int64, error) {
+	if id, ok := _coseAlgorithms[a]; ok {
+		return id, nil
+	}
+	return 0, fmt.Errorf("%w: %s", ErrNoCOSEAlgorithm, a)
+}
+
+var (
+	_coseEncMode, _ = cbor.CoreDetEncOptions().EncMode()
+	_coseDecMode, _ = cbor.DecOptions{
+		DupMapKey: cbor.DupMapKeyEnforcedAPF,
+		IntDec:    cbor.IntDecConvertSigned,
+	}.DecMode()
+)
+
+// COSEOptions are the optional parts of a COSE message.
+type COSEOptions struct {
+	// ContentType is the content type header of the body, a media type.
+	ContentType string
+	// ExternalAAD is authenticated together with the payload but not carried in the message.
+	ExternalAAD []byte
+	// Detached leaves the payload out of the message.
+	Detached bool
+}
+
+type coseSign1 struct {
+	_           struct{} `cbor:",toarray"`
+	Protected   []byte
+	Unprotected cbor.RawMessage
+	Payload     []byte
+	Signature   []byte
+}
+
+type coseSign struct {
+	_           struct{} `cbor:",toarray"`
+	Protected   []byte
+	Unprotected cbor.RawMessage
+	Payload     []byte
+	Signatures  []coseSignature
+}
+
+type coseSignature struct {
+	_           struct{} `cbor:",toarray"`
+	Protected   []byte
+	Unprotected cbor.RawMessage
+	Signature   []byte
+}
+
+// _coseEmptyMap is the encoding of an empty header map.
+var _coseEmptyMap = cbor.RawMessage{0xa0}
+
+// SignCOSE signs payload with every key. One key makes a tagged COSE_Sign1, several keys a tagged COSE_Sign with
+// a COSE_Signature per key. The protected headers carry alg and kid, the content type is a protected header of
+// the body.
+func SignCOSE(keys []*Key, payload []byte, options COSEOptions) ([]byte, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no COSE signer")
+	}
+
+	bodyHeader := map[int64]interface{}{}
+	if options.ContentType != "" {
+		bodyHeader[_coseHeaderContentType] = options.ContentType
+	}
+
+	if len(keys) == 1 {
+		for label, value := range coseKeyHeader(keys[0]) {
+			bodyHeader[label] = value
+		}
+	}
+
+	bodyProtected, err := coseProtected(bodyHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	var embedded []byte
+	if !options.Detached {
+		embedded = nonNil(payload)
+	}
+
+	if len(keys) == 1 {
+		signature, err := coseSignStructure(keys[0], []interface{}{"Signature1", bodyProtected, nonNil(options.ExternalAAD), nonNil(payload)})
+		if err != nil {
+			return nil, err
+		}
+
+		return _coseEncMode.Marshal(cbor.Tag{Number: _coseSign1Tag, Content: coseSign1{
+			Protected:   bodyProtected,
+			Unprotected: _coseEmptyMap,
+			Payload:     embedded,
+			Signature:   signature,
+		}})
+	}
+
+	message := coseSign{Protected: bodyProtected, Unprotected: _coseEmptyMap, Payload: embedded}
+	for _, key := range keys {
+		signProtected, err := coseProtected(coseKeyHeader(key))
+		if err != nil {
+			return nil, err
+		}
+
+		signature, err := coseSignStructure(key, []interface{}{"Signature", bodyProtected, signProtected, nonNil(options.ExternalAAD), nonNil(payload)})
+		if err != nil {
+			return nil, err
+		}
+
+		message.Signatures = append(message.Signatures, coseSignature{
+			Protected:   signProtected,
+			Unprotected: _coseEmptyMap,
+			Signature:   signature,
+		})
+	}
+
+	return _coseEncMode.Marshal(cbor.Tag{Number: _coseSignTag, Content: message})
+}
+
+// COSEVerification is the result of a successful VerifyCOSE.
+type COSEVerification struct {
+	// Keys made the signatures, in the order of the message.
+	Keys        []*Key
+	Payload     []byte
+	ContentType string
+}
+
+// VerifyCOSE checks a tagged COSE_Sign1 or COSE_Sign message against the keys of keyring. detachedPayload is used
+// when the message carries no payload. A COSE_Sign message is valid only when every signature verifies.
+func VerifyCOSE(keyring *Keyring, message, detachedPayload, externalAAD []byte) (*COSEVerification, error) {
+	var tag cbor.RawTag
+	if err := _coseDecMode.Unmarshal(message, &tag); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedCOSE, err)
+	}
+
+	switch tag.Number {
+	case _coseSign1Tag:
+		var sign1 coseSign1
+		if err := _coseDecMode.Unmarshal(tag.Content, &sign1); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedCOSE, err)
+		}
+
+		verification, payload, err := coseBody(sign1.Protected, sign1.Payload, detachedPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := coseVerify(keyring, sign1.Protected, sign1.Signature,
+			[]interface{}{"Signature1", sign1.Protected, nonNil(externalAAD), payload})
+		if err != nil {
+			return nil, err
+		}
+
+		verification.Keys = []*Key{key}
+		return verification, nil
+	case _coseSignTag:
+		var sign coseSign
+		if err := _coseDecMode.Unmarshal(tag.Content, &sign); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrMalformedCOSE, err)
+		}
+
+		if len(sign.Signatures) == 0 {
+			return nil, fmt.Errorf("%w: no signatures", ErrMalformedCOSE)
+		}
+
+		verification, payload, err := coseBody(sign.Protected, sign.Payload, detachedPayload)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, signature := range sign.Signatures {
+			key, err := coseVerify(keyring, signature.Protected, signature.Signature,
+				[]interface{}{"Signature", sign.Protected, nonNil(signature.Protected), nonNil(externalAAD), payload})
+			if err != nil {
+				return nil, err
+			}
+			verification.Keys = append(verification.Keys, key)
+		}
+		return verification, nil
+	default:
+		return nil, fmt.Errorf("%w: tag %d", ErrMalformedCOSE, tag.Number)
+	}
+}
+
+var errCOSEMismatch = errors.New("COSE signature mismatch")
+
+func coseKeyHeader(key *Key) map[int64]interface{} {
+	alg, _ := key.Algorithm.COSEAlgorithm()
+	return map[int64]interface{}{_coseHeaderAlgorithm: alg, _coseHeaderKeyID: []byte(key.ID)}
+}
+
+func coseProtected(header map[int64]interface{}) ([]byte, error) {
+	if len(header) == 0 {
+		return []byte{}, nil
+	}
+	return _coseEncMode.Marshal(header)
+}
+
+func coseSignStructure(key *Key, sigStructure []interface{}) ([]byte, error) {
+	if _, err := key.Algorithm.COSEAlgorithm(); err != nil {
+		return nil, err
+	}
+
+	toBeSigned, err := _coseEncMode.Marshal(sigStructure)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := key.Sign(toBeSigned)
+	if err != nil {
+		return nil, err
+	}
+	return key.Algorithm.rawSignature(signature)
+}
+
+// coseBody reads the protected headers of the message body and picks the embedded or the detached payload.
+func coseBody(protected, embedded, detachedPayload []byte) (*COSEVerification, []byte, error) {
+	header, err := coseHeader(protected)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verification := &COSEVerification{Payload: embedded}
+	if embedded == nil {
+		verification.Payload = detachedPayload
+	}
+
+	switch contentType := header[int64(_coseHeaderContentType)].(type) {
+	case nil:
+	case string:
+		verification.ContentType = contentType
+	case int64:
+		verification.ContentType = strconv.FormatInt(contentType, 10)
+	default:
+		return nil, nil, fmt.Errorf("%w: content type of type %T", ErrMalformedCOSE, contentType)
+	}
+
+	return verification, nonNil(verification.Payload), nil
+}
+
+func coseHeader(protected []byte) (map[interface{}]interface{}, error) {
+	header := map[interface{}]interface{}{}
+	if len(protected) == 0 {
+		return header, nil
+	}
+
+	if err := _coseDecMode.Unmarshal(protected, &header); err != nil {
+		return nil, fmt.Errorf("%w: protected header: %v", ErrMalformedCOSE, err)
+	}
+
+	// No critical header parameters are understood.
+	if _, ok := header[int64(_coseHeaderCritical)]; ok {
+		return nil, fmt.Errorf("%w: critical header parameters", ErrMalformedCOSE)
+	}
+	return header, nil
+}
+
+// coseVerify finds the key of a signature by its protected kid and checks the signature with it.
+func coseVerify(keyring *Keyring, protected, signature []byte, sigStructure []interface{}) (*Key, error) {
+	header, err := coseHeader(protected)
+	if err != nil {
+		return nil, err
+	}
+
+	alg, _ := header[int64(_coseHeaderAlgorithm)].(int64)
+	kid, _ := header[int64(_coseHeaderKeyID)].([]byte)
+	if len(kid) == 0 {
+		return nil, fmt.Errorf("%w: no protected kid", ErrMalformedCOSE)
+	}
+
+	key, ok := keyring.Lookup(string(kid))
+	if !ok {
+		return nil, fmt.Errorf("%w: %w %q", errCOSEMismatch, ErrUnknownKey, kid)
+	}
+
+	if keyAlg, err := key.Algorithm.COSEAlgorithm(); err != nil || keyAlg != alg {
+		return nil, fmt.Errorf("%w: algorithm %d for a %s key", errCOSEMismatch, alg, key.Algorithm)
+	}
+
+	toBeVerified, err := _coseEncMode.Marshal(sigStructure)
+	if err != nil {
+		return nil, err
+	}
+
+	asn1Signature, err := key.Algorithm.asn1Signature(signature)
+	if err != nil || !key.Verify(toBeVerified, asn1Signature) {
+		return nil, errCOSEMismatch
+	}
+	return key, nil
+}
+
+// nonNil makes nil byte strings encode as empty CBOR byte strings rather than null.
+func nonNil(data []byte) []byte {
+	if data == nil {
+		return []byte{}
+	}
+	return data
+}
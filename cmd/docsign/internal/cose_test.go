@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignCOSE(t *testing.T) {
+	t.Parallel()
+
+	ed25519Key := newTestKey(t)
+	ecdsaKey := newTestAlgorithmKey(t, AlgorithmECDSAP384SHA384)
+	rsaKey := newTestAlgorithmKey(t, AlgorithmRSAPSS2048SHA256)
+	mldsaKey := newTestAlgorithmKey(t, AlgorithmMLDSA65)
+
+	keyring := NewKeyring(ed25519Key)
+	keyring.SetCosigners(ecdsaKey, rsaKey, mldsaKey)
+
+	tests := []struct {
+		name    string
+		keys    []*Key
+		options COSEOptions
+		tag     uint64
+	}{
+		{name: "Case #1", keys: []*Key{ed25519Key}, tag: _coseSign1Tag},
+		{name: "Case #2", keys: []*Key{ecdsaKey}, options: COSEOptions{ContentType: "application/cbor", ExternalAAD: []byte("aad")}, tag: _coseSign1Tag},
+		{name: "Case #3", keys: []*Key{mldsaKey}, options: COSEOptions{Detached: true}, tag: _coseSign1Tag},
+		{name: "Case #4", keys: []*Key{ed25519Key, ecdsaKey, rsaKey, mldsaKey}, tag: _coseSignTag},
+		{name: "Case #5", keys: []*Key{rsaKey, ed25519Key}, options: COSEOptions{ContentType: "text/plain", ExternalAAD: []byte("aad"), Detached: true}, tag: _coseSignTag},
+	}
+
+	payload := randData(t, 100)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := SignCOSE(tt.keys, payload, tt.options)
+			assert.NoError(t, err)
+
+			var tag cbor.RawTag
+			assert.NoError(t, cbor.Unmarshal(message, &tag))
+			assert.Equal(t, tt.tag, tag.Number)
+
+			var detached []byte
+			if tt.options.Detached {
+				detached = payload
+			}
+
+			verification, err := VerifyCOSE(keyring, message, detached, tt.options.ExternalAAD)
+			assert.NoError(t, err)
+			assert.Equal(t, payload, verification.Payload)
+			assert.Equal(t, tt.options.ContentType, verification.ContentType)
+			assert.Len(t, verification.Keys, len(tt.keys))
+			for i, key := range tt.keys {
+				assert.Equal(t, key.ID, verification.Keys[i].ID)
+			}
+
+			_, err = VerifyCOSE(keyring, message, detached, []byte("other aad"))
+			assert.Error(t, err)
+
+			if tt.options.Detached {
+				_, err = VerifyCOSE(keyring, message, payload[1:], tt.options.ExternalAAD)
+				assert.Error(t, err)
+			}
+
+			// Every signer must be known.
+			_, err = VerifyCOSE(NewKeyring(tt.keys[0]), message, detached, tt.options.ExternalAAD)
+			assert.Equal(t, len(tt.keys) == 1, err == nil)
+
+			tampered := append([]byte(nil), message...)
+			tampered[len(tampered)-1] ^= 1
+			_, err = VerifyCOSE(keyring, tampered, detached, tt.options.ExternalAAD)
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := SignCOSE([]*Key{newTestAlgorithmKey(t, AlgorithmEd25519MLDSA65)}, payload, COSEOptions{})
+	assert.ErrorIs(t, err, ErrNoCOSEAlgorithm)
+}
+
+func TestVerifyCOSE_Malformed(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	keyring := NewKeyring(key)
+
+	message, err := SignCOSE([]*Key{key}, []byte("payload"), COSEOptions{})
+	assert.NoError(t, err)
+
+	var sign1 coseSign1
+	assert.NoError(t, cbor.Unmarshal(message[1:], &sign1))
+
+	encode := func(tag uint64, content interface{}) []byte {
+		encoded, err := cbor.Marshal(cbor.Tag{Number: tag, Content: content})
+		assert.NoError(t, err)
+		return encoded
+	}
+
+	withProtected := func(header map[int64]interface{}) []byte {
+		protected, err := _coseEncMode.Marshal(header)
+		assert.NoError(t, err)
+		return encode(_coseSign1Tag, coseSign1{Protected: protected, Unprotected: _coseEmptyMap, Payload: sign1.Payload, Signature: sign1.Signature})
+	}
+
+	tests := map[string][]byte{
+		"Case #1": nil,
+		"Case #2": message[1:],
+		"Case #3": encode(_coseSignTag, sign1),
+		"Case #4": encode(_coseSignTag, coseSign{Protected: []byte{}, Unprotected: _coseEmptyMap, Payload: []byte("payload")}),
+		"Case #5": withProtected(map[int64]interface{}{_coseHeaderAlgorithm: -8}),
+		"Case #6": withProtected(map[int64]interface{}{_coseHeaderAlgorithm: -7, _coseHeaderKeyID: []byte(key.ID)}),
+		"Case #7": withProtected(map[int64]interface{}{_coseHeaderAlgorithm: -8, _coseHeaderKeyID: []byte(key.ID), _coseHeaderCritical: []int{_coseHeaderKeyID}}),
+		"Case #8": withProtected(map[int64]interface{}{_coseHeaderAlgorithm: -8, _coseHeaderKeyID: []byte("unknown")}),
+		"Case #9": encode(_coseSign1Tag, coseSign1{Protected: []byte{0xff}, Unprotected: _coseEmptyMap, Payload: sign1.Payload, Signature: sign1.Signature}),
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			_, err := VerifyCOSE(keyring, tt, nil, nil)
+			assert.Error(t, err)
+		})
+	}
+
+	verification, err := VerifyCOSE(keyring, withProtected(map[int64]interface{}{_coseHeaderAlgorithm: -8, _coseHeaderKeyID: []byte(key.ID)}), nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("payload"), verification.Payload)
+}
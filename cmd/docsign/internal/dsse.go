@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// InTotoPayloadType is the DSSE payload type of in-toto attestations.
+const InTotoPayloadType = "application/vnd.in-toto+json"
+
+var (
+	ErrMalformedDSSE = errors.New("malformed DSSE envelope")
+
+	errDSSEMismatch = errors.New("no DSSE signature verifies")
+)
+
+type dsseEnvelope struct {
+	Payload     string          `json:"payload"`
+	PayloadType string          `json:"payloadType"`
+	Signatures  []dsseSignature `json:"signatures"`
+}
+
+type dsseSignature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   string `json:"sig"`
+}
+
+// DSSEVerification is the result of a successful VerifyDSSE.
+type DSSEVerification struct {
+	// Keys made the signatures which verify, in the order of the envelope.
+	Keys        []*Key
+	PayloadType string
+	Payload     []byte
+}
+
+// DSSEPreAuthEncoding returns the DSSE v1 pre-authentication encoding of a payload, the data its signatures sign.
+func DSSEPreAuthEncoding(payloadType string, payload []byte) []byte {
+	encoding := []byte("DSSEv1 ")
+	encoding = strconv.AppendInt(encoding, int64(len(payloadType)), 10)
+	encoding = append(encoding, ' ')
+	encoding = append(encoding, payloadType...)
+	encoding = append(encoding, ' ')
+	encoding = strconv.AppendInt(encoding, int64(len(payload)), 10)
+	encoding = append(encoding, ' ')
+	return append(encoding, payload...)
+}
+
+// SignDSSE returns the JSON DSSE envelope of payload with a signature of every key. Signatures are those of
+// Key.Sign, the key id is the signature keyid.
+func SignDSSE(keys []*Key, payloadType string, payload []byte) ([]byte, error) {
+	toBeSigned := DSSEPreAuthEncoding(payloadType, payload)
+
+	envelope := dsseEnvelope{
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		PayloadType: payloadType,
+		Signatures:  make([]dsseSignature, len(keys)),
+	}
+	for i, key := range keys {
+		signature, err := key.Sign(toBeSigned)
+		if err != nil {
+			return nil, err
+		}
+		envelope.Signatures[i] = dsseSignature{KeyID: key.ID, Sig: base64.StdEncoding.EncodeToString(signature)}
+	}
+
+	return json.Marshal(envelope)
+}
+
+// VerifyDSSE checks a JSON DSSE envelope against the keys of keyring. The envelope is valid when at least one
+// signature verifies, signatures of unknown keys and signatures which do not verify are skipped. Signatures without
+// a keyid are tried with every key.
+func VerifyDSSE(keyring *Keyring, envelope []byte) (*DSSEVerification, error) {
+	var parsed dsseEnvelope
+	if err := json.Unmarshal(envelope, &parsed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedDSSE, err)
+	}
+
+	payload, err := dsseDecode(parsed.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrMalformedDSSE, err)
+	}
+
+	if len(parsed.Signatures) == 0 {
+		return nil, fmt.Errorf("%w: no signatures", ErrMalformedDSSE)
+	}
+
+	toBeVerified := DSSEPreAuthEncoding(parsed.PayloadType, payload)
+
+	verification := &DSSEVerification{PayloadType: parsed.PayloadType, Payload: payload}
+	verified := map[string]bool{}
+	for _, signature := range parsed.Signatures {
+		sig, err := dsseDecode(signature.Sig)
+		if err != nil {
+			return nil, fmt.Errorf("%w: signature: %v", ErrMalformedDSSE, err)
+		}
+
+		candidates := keyring.Keys()
+		if signature.KeyID != "" {
+			key, ok := keyring.Lookup(signature.KeyID)
+			if !ok {
+				continue
+			}
+			candidates = []*Key{key}
+		}
+
+		for _, key := range candidates {
+			// A key counts once however many of its signatures the envelope carries.
+			if !verified[key.ID] && key.Verify(toBeVerified, sig) {
+				verified[key.ID] = true
+				verification.Keys = append(verification.Keys, key)
+				break
+			}
+		}
+	}
+
+	if len(verification.Keys) == 0 {
+		return nil, errDSSEMismatch
+	}
+	return verification, nil
+}
+
+// dsseDecode decodes standard or URL-safe, padded or unpadded base64 as DSSE requires.
+func dsseDecode(encoded string) ([]byte, error) {
+	for _, encoding := range []*base64.Encoding{base64.StdEncoding, base64.URLEncoding, base64.RawStdEncoding, base64.RawURLEncoding} {
+		if decoded, err := encoding.DecodeString(encoded); err == nil {
+			return decoded, nil
+		}
+	}
+	return nil, errors.New("invalid base64")
+}
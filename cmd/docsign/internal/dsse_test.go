@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDSSEPreAuthEncoding(t *testing.T) {
+	t.Parallel()
+
+	// The example of the DSSE protocol specification.
+	assert.Equal(t, "DSSEv1 29 http://example.com/HelloWorld 11 hello world",
+		string(DSSEPreAuthEncoding("http://example.com/HelloWorld", []byte("hello world"))))
+	assert.Equal(t, "DSSEv1 0  0 ", string(DSSEPreAuthEncoding("", nil)))
+}
+
+func TestSignDSSE(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"_type":"https://in-toto.io/Statement/v1","subject":[],"predicateType":"https://slsa.dev/provenance/v1"}`)
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmRSAPSS2048SHA256, AlgorithmMLDSA44, AlgorithmEd25519MLDSA65} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			key, cosigner := newTestAlgorithmKey(t, algorithm), newTestKey(t)
+			keyring := NewKeyring(key, cosigner)
+
+			envelope, err := SignDSSE([]*Key{key, cosigner}, InTotoPayloadType, payload)
+			assert.NoError(t, err)
+
+			var parsed dsseEnvelope
+			assert.NoError(t, json.Unmarshal(envelope, &parsed))
+			assert.Equal(t, InTotoPayloadType, parsed.PayloadType)
+			assert.Equal(t, base64.StdEncoding.EncodeToString(payload), parsed.Payload)
+			assert.Len(t, parsed.Signatures, 2)
+			assert.Equal(t, key.ID, parsed.Signatures[0].KeyID)
+
+			verification, err := VerifyDSSE(keyring, envelope)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{key.ID, cosigner.ID}, dsseKeyIDs(verification))
+			assert.Equal(t, InTotoPayloadType, verification.PayloadType)
+			assert.Equal(t, payload, verification.Payload)
+
+			// Signatures of unknown keys are skipped.
+			verification, err = VerifyDSSE(NewKeyring(cosigner), envelope)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{cosigner.ID}, dsseKeyIDs(verification))
+
+			_, err = VerifyDSSE(NewKeyring(newTestKey(t)), envelope)
+			assert.Error(t, err)
+
+			// The payload type is signed.
+			parsed.PayloadType = "application/json"
+			tampered, err := json.Marshal(parsed)
+			assert.NoError(t, err)
+			_, err = VerifyDSSE(keyring, tampered)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVerifyDSSE_Malformed(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	keyring := NewKeyring(key)
+	payload := randData(t, 100)
+
+	envelope, err := SignDSSE([]*Key{key}, InTotoPayloadType, payload)
+	assert.NoError(t, err)
+
+	var parsed dsseEnvelope
+	assert.NoError(t, json.Unmarshal(envelope, &parsed))
+	signature := parsed.Signatures[0]
+
+	withSignatures := func(signatures ...dsseSignature) []byte {
+		modified := parsed
+		modified.Signatures = signatures
+		encoded, err := json.Marshal(modified)
+		assert.NoError(t, err)
+		return encoded
+	}
+
+	withPayload := func(payload string) []byte {
+		modified := parsed
+		modified.Payload = payload
+		encoded, err := json.Marshal(modified)
+		assert.NoError(t, err)
+		return encoded
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(signature.Sig)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		envelope   []byte
+		shouldFail bool
+	}{
+		{name: "Case #1", envelope: nil, shouldFail: true},
+		{name: "Case #2", envelope: []byte("{"), shouldFail: true},
+		{name: "Case #3", envelope: withSignatures(), shouldFail: true},
+		{name: "Case #4", envelope: withPayload("!"), shouldFail: true},
+		{name: "Case #5", envelope: withPayload(base64.StdEncoding.EncodeToString(payload[1:])), shouldFail: true},
+		{name: "Case #6", envelope: withSignatures(dsseSignature{KeyID: key.ID, Sig: "!"}), shouldFail: true},
+		{name: "Case #7", envelope: withSignatures(dsseSignature{KeyID: "unknown", Sig: signature.Sig}), shouldFail: true},
+		{name: "Case #8", envelope: withSignatures(dsseSignature{Sig: signature.Sig}), shouldFail: false},
+		{name: "Case #9", envelope: withSignatures(dsseSignature{KeyID: key.ID, Sig: base64.RawURLEncoding.EncodeToString(decoded)}), shouldFail: false},
+		{name: "Case #10", envelope: withPayload(base64.RawURLEncoding.EncodeToString(payload)), shouldFail: false},
+		{name: "Case #11", envelope: withSignatures(dsseSignature{KeyID: "unknown", Sig: signature.Sig}, signature), shouldFail: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verification, err := VerifyDSSE(keyring, tt.envelope)
+			if tt.shouldFail {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, []string{key.ID}, dsseKeyIDs(verification))
+		})
+	}
+
+	// A key counts once.
+	verification, err := VerifyDSSE(keyring, withSignatures(signature, signature))
+	assert.NoError(t, err)
+	assert.Len(t, verification.Keys, 1)
+}
+
+func dsseKeyIDs(verification *DSSEVerification) []string {
+	ids := make([]string, len(verification.Keys))
+	for i, key := range verification.Keys {
+		ids[i] = key.ID
+	}
+	return ids
+}
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// _envelopeContext starts the canonical encoding of an envelope, so it never is a valid encoding of any other
+// format the service signs.
+const _envelopeContext = "docsign-envelope-v1\x00"
+
+// _reservedContexts start the encodings the service builds and signs on its own. Raw documents starting with one of
+// them are refused, the key signs them with no context of their own.
+var _reservedContexts = []string{_envelopeContext, _merkleTreeHeadContext}
+
+var (
+	ErrInvalidEnvelope  = errors.New("invalid signature envelope")
+	ErrSignatureExpired = errors.New("signature expired")
+	ErrPurposeMismatch  = errors.New("signature purpose mismatch")
+	ErrReservedContext  = errors.New("document starts with a reserved context string")
+	errEnvelopeMismatch = fmt.Errorf("envelope %w", ErrSignatureMismatch)
+)
+
+// checkReservedContext makes sure data, a raw document, is no encoding of an envelope or of another structure the
+// service signs, see _reservedContexts.
+func checkReservedContext(data []byte) error {
+	for _, context := range _reservedContexts {
+		if bytes.HasPrefix(data, []byte(context)) {
+			return fmt.Errorf("%w %q", ErrReservedContext, context)
+		}
+	}
+	return nil
+}
+
+// SignatureEnvelope is the metadata a signature of a document covers together with the document.
+type SignatureEnvelope struct {
+	KeyID     string
+	Algorithm Algorithm
+	SignedAt  time.Time
+	// ExpiresAt is the end of the validity of the signature, zero when it does not expire.
+	ExpiresAt time.Time
+	// Purpose is the namespace of the signature, the verifier must expect the same one.
+	Purpose    string
+	Attributes map[string]string
+}
+
+// Encode returns the canonical encoding of the envelope and data, the data envelope signatures sign: the context
+// string followed by the key id, algorithm name, signing time, expiry, purpose, attributes sorted by name and the
+// SHA-512 digest of data. Strings are prefixed with their 32-bit big-endian length, times are 64-bit seconds and
+// 32-bit nanoseconds since the Unix epoch preceded by a presence byte.
+func (e *SignatureEnvelope) Encode(data []byte) []byte {
+	encoding := []byte(_envelopeContext)
+	encoding = appendEnvelopeString(encoding, e.KeyID)
+	encoding = appendEnvelopeString(encoding, e.Algorithm.String())
+	encoding = appendEnvelopeTime(encoding, e.SignedAt)
+	encoding = appendEnvelopeTime(encoding, e.ExpiresAt)
+	encoding = appendEnvelopeString(encoding, e.Purpose)
+
+	names := make([]string, 0, len(e.Attributes))
+	for name := range e.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	encoding = binary.BigEndian.AppendUint32(encoding, uint32(len(names)))
+	for _, name := range names {
+		encoding = appendEnvelopeString(encoding, name)
+		encoding = appendEnvelopeString(encoding, e.Attributes[name])
+	}
+
+	digest := sha512.Sum512(data)
+	return append(encoding, digest[:]...)
+}
+
+func appendEnvelopeString(encoding []byte, value string) []byte {
+	encoding = binary.BigEndian.AppendUint32(encoding, uint32(len(value)))
+	return append(encoding, value...)
+}
+
+func appendEnvelopeTime(encoding []byte, t time.Time) []byte {
+	if t.IsZero() {
+		return append(encoding, 0)
+	}
+
+	encoding = append(encoding, 1)
+	encoding = binary.BigEndian.AppendUint64(encoding, uint64(t.Unix()))
+	return binary.BigEndian.AppendUint32(encoding, uint32(t.Nanosecond()))
+}
+
+// SignEnvelope signs data together with envelope. The key id, algorithm and, when zero, the signing time of the
+// envelope are set from key and the current time.
+func SignEnvelope(key *Key, data []byte, envelope *SignatureEnvelope) ([]byte, error) {
+	envelope.KeyID, envelope.Algorithm = key.ID, key.Algorithm
+	if envelope.SignedAt.IsZero() {
+		envelope.SignedAt = time.Now()
+	}
+
+	if !envelope.ExpiresAt.IsZero() && !envelope.ExpiresAt.After(envelope.SignedAt) {
+		return nil, fmt.Errorf("%w: expires at %s, before it is signed", ErrInvalidEnvelope, envelope.ExpiresAt.Format(time.RFC3339))
+	}
+
+	for name := range envelope.Attributes {
+		if name == "" {
+			return nil, fmt.Errorf("%w: attribute without a name", ErrInvalidEnvelope)
+		}
+	}
+
+	return key.Sign(envelope.Encode(data))
+}
+
+// VerifyEnvelope checks a signature of data and envelope made by SignEnvelope with a key of keyring. The signature
+// must not be expired and its purpose must be purpose. The key must be of the algorithm of the envelope.
+func VerifyEnvelope(keyring *Keyring, data, signature []byte, envelope *SignatureEnvelope, purpose string) (*Key, error) {
+	if envelope.KeyID == "" || envelope.SignedAt.IsZero() {
+		return nil, fmt.Errorf("%w: no key id or signing time", ErrInvalidEnvelope)
+	}
+
+	key, err := keyring.verifyingKey(envelope.KeyID, envelope.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSignatureFormat(key, signature); err != nil {
+		return nil, err
+	}
+
+	if !key.Verify(envelope.Encode(data), signature) {
+		return nil, errEnvelopeMismatch
+	}
+
+	if !envelope.ExpiresAt.IsZero() && time.Now().After(envelope.ExpiresAt) {
+		return nil, fmt.Errorf("%w at %s", ErrSignatureExpired, envelope.ExpiresAt.Format(time.RFC3339))
+	}
+
+	if envelope.Purpose != purpose {
+		return nil, fmt.Errorf("%w: %q, expected %q", ErrPurposeMismatch, envelope.Purpose, purpose)
+	}
+	return key, nil
+}
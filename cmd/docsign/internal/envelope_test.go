@@ -0,0 +1,148 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignatureEnvelope_Encode(t *testing.T) {
+	t.Parallel()
+
+	envelope := &SignatureEnvelope{
+		KeyID:      "k",
+		Algorithm:  AlgorithmEd25519,
+		SignedAt:   time.Unix(1, 2),
+		Purpose:    "p",
+		Attributes: map[string]string{"b": "2", "a": "1"},
+	}
+
+	expected := "646f637369676e2d656e76656c6f70652d763100" + // docsign-envelope-v1\0
+		"000000016b" + // key id
+		"0000000765643235353139" + // ed25519
+		"01" + "0000000000000001" + "00000002" + // signed at
+		"00" + // no expiry
+		"0000000170" + // purpose
+		"00000002" + "0000000161" + "0000000131" + "0000000162" + "0000000132" // attributes in name order
+
+	encoding := envelope.Encode(nil)
+	assert.Equal(t, expected, hex.EncodeToString(encoding[:len(encoding)-64]))
+
+	// The attributes are not ambiguous.
+	other := *envelope
+	other.Attributes = map[string]string{"a": "1b", "": "2"}
+	assert.False(t, bytes.Equal(encoding, other.Encode(nil)))
+	assert.False(t, bytes.Equal(encoding, envelope.Encode([]byte{0})))
+}
+
+func TestSignEnvelope(t *testing.T) {
+	t.Parallel()
+
+	data := randData(t, 100)
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmRSAPSS2048SHA256, AlgorithmMLDSA44, AlgorithmEd25519MLDSA65} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			key := newTestAlgorithmKey(t, algorithm)
+			keyring := NewKeyring(newTestKey(t), key.retire())
+
+			envelope := &SignatureEnvelope{
+				ExpiresAt:  time.Now().Add(time.Hour),
+				Purpose:    "release",
+				Attributes: map[string]string{"build": "1234", "commit": "5eed"},
+			}
+			signature, err := SignEnvelope(key, data, envelope)
+			assert.NoError(t, err)
+			assert.Equal(t, key.ID, envelope.KeyID)
+			assert.Equal(t, algorithm, envelope.Algorithm)
+			assert.WithinDuration(t, time.Now(), envelope.SignedAt, time.Minute)
+
+			verifyingKey, err := VerifyEnvelope(keyring, data, signature, envelope, "release")
+			assert.NoError(t, err)
+			assert.Equal(t, key.ID, verifyingKey.ID)
+
+			// Enveloped signatures are not signatures of the document.
+			assert.False(t, key.Verify(data, signature))
+		})
+	}
+}
+
+func TestVerifyEnvelope(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	keyring := NewKeyring(key)
+	data := randData(t, 100)
+
+	sign := func(envelope SignatureEnvelope) (*SignatureEnvelope, []byte) {
+		signature, err := SignEnvelope(key, data, &envelope)
+		assert.NoError(t, err)
+		return &envelope, signature
+	}
+
+	envelope, signature := sign(SignatureEnvelope{Purpose: "release", Attributes: map[string]string{"build": "1234"}})
+
+	otherAttributes := *envelope
+	otherAttributes.Attributes = map[string]string{"build": "1235"}
+
+	otherPurpose := *envelope
+	otherPurpose.Purpose = "test"
+
+	otherTime := *envelope
+	otherTime.SignedAt = envelope.SignedAt.Add(time.Nanosecond)
+
+	withExpiry := *envelope
+	withExpiry.ExpiresAt = time.Now().Add(time.Hour)
+
+	otherAlgorithm := *envelope
+	otherAlgorithm.Algorithm = AlgorithmECDSAP256SHA256
+
+	unknownKey := *envelope
+	unknownKey.KeyID = newTestKey(t).ID
+
+	withoutTime := *envelope
+	withoutTime.SignedAt = time.Time{}
+
+	expiring, expiringSignature := sign(SignatureEnvelope{SignedAt: time.Now().Add(-time.Hour), ExpiresAt: time.Now().Add(-time.Minute)})
+
+	tests := []struct {
+		name      string
+		envelope  *SignatureEnvelope
+		data      []byte
+		signature []byte
+		purpose   string
+		err       error
+	}{
+		{name: "Case #1", envelope: envelope, data: data, signature: signature, purpose: "release", err: nil},
+		{name: "Case #2", envelope: envelope, data: data, signature: signature, purpose: "", err: ErrPurposeMismatch},
+		{name: "Case #3", envelope: envelope, data: data, signature: signature, purpose: "test", err: ErrPurposeMismatch},
+		{name: "Case #4", envelope: &otherPurpose, data: data, signature: signature, purpose: "test", err: errEnvelopeMismatch},
+		{name: "Case #5", envelope: &otherAttributes, data: data, signature: signature, purpose: "release", err: errEnvelopeMismatch},
+		{name: "Case #6", envelope: &otherTime, data: data, signature: signature, purpose: "release", err: errEnvelopeMismatch},
+		{name: "Case #7", envelope: &withExpiry, data: data, signature: signature, purpose: "release", err: errEnvelopeMismatch},
+		{name: "Case #8", envelope: &otherAlgorithm, data: data, signature: signature, purpose: "release", err: ErrUnknownKey},
+		{name: "Case #9", envelope: &unknownKey, data: data, signature: signature, purpose: "release", err: ErrUnknownKey},
+		{name: "Case #10", envelope: &withoutTime, data: data, signature: signature, purpose: "release", err: ErrInvalidEnvelope},
+		{name: "Case #11", envelope: envelope, data: data[1:], signature: signature, purpose: "release", err: errEnvelopeMismatch},
+		{name: "Case #12", envelope: expiring, data: data, signature: expiringSignature, purpose: "", err: ErrSignatureExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyEnvelope(keyring, tt.data, tt.signature, tt.envelope, tt.purpose)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	_, err := SignEnvelope(key, data, &SignatureEnvelope{ExpiresAt: time.Now().Add(-time.Minute)})
+	assert.ErrorIs(t, err, ErrInvalidEnvelope)
+
+	_, err = SignEnvelope(key, data, &SignatureEnvelope{Attributes: map[string]string{"": "value"}})
+	assert.ErrorIs(t, err, ErrInvalidEnvelope)
+}
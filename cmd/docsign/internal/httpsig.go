@@ -0,0 +1,473 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	_httpSignatureDefaultLabel = "sig1"
+	_contentDigestField        = "content-digest"
+)
+
+var (
+	ErrNoHTTPSignatureAlgorithm = errors.New("no HTTP message signature algorithm")
+	ErrInvalidHTTPComponent     = errors.New("invalid HTTP message component")
+	ErrMalformedHTTPSignature   = errors.New("malformed HTTP message signature")
+
+	errHTTPSignatureMismatch = errors.New("HTTP message signature mismatch")
+	errHTTPSignatureExpired  = fmt.Errorf("HTTP message %w", ErrSignatureExpired)
+)
+
+// RFC 9421 algorithm names. RSA-PSS keys sign SHA-512 digests with 64 byte salts as rsa-pss-sha512 requires,
+// ECDSA signatures are r || s.
+var _httpSignatureAlgorithms = map[Algorithm]string{
+	AlgorithmEd25519:          "ed25519",
+	AlgorithmECDSAP256SHA256:  "ecdsa-p256-sha256",
+	AlgorithmECDSAP384SHA384:  "ecdsa-p384-sha384",
+	AlgorithmRSAPSS2048SHA256: "rsa-pss-sha512",
+	AlgorithmRSAPSS3072SHA256: "rsa-pss-sha512",
+	AlgorithmRSAPSS4096SHA256: "rsa-pss-sha512",
+}
+
+// HTTPSignatureAlgorithm returns the RFC 9421 "alg" parameter of HTTP message signatures made with the algorithm.
+// ML-DSA and hybrid keys have none.
+func (a Algorithm) HTTPSignatureAlgorithm() (string, error) {
+	if name, ok := _httpSignatureAlgorithms[a]; ok {
+		return name, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrNoHTTPSignatureAlgorithm, a)
+}
+
+// HTTPMessage is the part of an HTTP request or response HTTP message signatures cover.
+type HTTPMessage struct {
+	Method    string
+	TargetURI *url.URL
+	// Status is the status code of a response.
+	Status int
+	Header http.Header
+	// Content is the body, nil when it is not known.
+	Content []byte
+}
+
+// HTTPSignatureOptions tells what SignHTTPMessage signs.
+type HTTPSignatureOptions struct {
+	// Label names the signature in the Signature-Input and Signature fields, "sig1" when empty.
+	Label string
+	// Components are the covered component identifiers in order: derived components such as "@method",
+	// "@target-uri", "@authority", "@scheme", "@request-target", "@path", "@query" and "@status", and lower case
+	// field names.
+	Components []string
+	// Created is the time of the signature, now when zero.
+	Created time.Time
+	// Expires, Nonce and Tag are the optional signature parameters of the same names.
+	Expires time.Time
+	Nonce   string
+	Tag     string
+}
+
+// HTTPSignature holds the field values which carry an HTTP message signature.
+type HTTPSignature struct {
+	Label          string
+	SignatureInput string
+	Signature      string
+	// Base is the signature base, the signed string.
+	Base string
+	// ContentDigest is the Content-Digest field the signature covers when the message has none, empty otherwise.
+	ContentDigest string
+}
+
+// HTTPVerification is the result of a successful VerifyHTTPMessage.
+type HTTPVerification struct {
+	Key        *Key
+	Label      string
+	Components []string
+	Created    time.Time
+	Expires    time.Time
+	Nonce      string
+	Tag        string
+}
+
+// SignHTTPMessage makes an RFC 9421 HTTP message signature of message with key. The signature parameters are
+// created, expires, keyid, alg, nonce and tag. When "content-digest" is covered and the message has no
+// Content-Digest field, the SHA-256 Content-Digest of the content is signed and returned.
+func SignHTTPMessage(key *Key, message *HTTPMessage, options HTTPSignatureOptions) (*HTTPSignature, error) {
+	alg, err := key.Algorithm.HTTPSignatureAlgorithm()
+	if err != nil {
+		return nil, err
+	}
+
+	label := options.Label
+	if label == "" {
+		label = _httpSignatureDefaultLabel
+	}
+
+	if !isSFKey(label) {
+		return nil, fmt.Errorf("%w: label %q", ErrInvalidHTTPComponent, label)
+	}
+
+	signature := &HTTPSignature{Label: label}
+
+	signed := *message
+	signed.Header = message.Header.Clone()
+	if signed.Header == nil {
+		signed.Header = http.Header{}
+	}
+
+	components := make([]sfItem, len(options.Components))
+	for i, component := range options.Components {
+		components[i] = sfItem{Value: component}
+		if component == _contentDigestField && signed.Header.Get(_contentDigestField) == "" {
+			signature.ContentDigest = ContentDigest(message.Content)
+			signed.Header.Set(_contentDigestField, signature.ContentDigest)
+		}
+	}
+
+	created := options.Created
+	if created.IsZero() {
+		created = time.Now()
+	}
+
+	parameters := []sfParameter{{Key: "created", Value: created.Unix()}}
+	if !options.Expires.IsZero() {
+		parameters = append(parameters, sfParameter{Key: "expires", Value: options.Expires.Unix()})
+	}
+	parameters = append(parameters, sfParameter{Key: "keyid", Value: key.ID}, sfParameter{Key: "alg", Value: alg})
+	if options.Nonce != "" {
+		parameters = append(parameters, sfParameter{Key: "nonce", Value: options.Nonce})
+	}
+	if options.Tag != "" {
+		parameters = append(parameters, sfParameter{Key: "tag", Value: options.Tag})
+	}
+
+	base, signatureParams, err := httpSignatureBase(&signed, components, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := httpSign(key, base)
+	if err != nil {
+		return nil, err
+	}
+
+	signature.Base = base
+	signature.SignatureInput = label + "=" + signatureParams
+	signature.Signature = label + "=:" + base64.StdEncoding.EncodeToString(raw) + ":"
+	return signature, nil
+}
+
+// VerifyHTTPMessage checks the HTTP message signature labelled label of message against the keys of keyring, or
+// the first signature which verifies when label is empty. The signature must cover the required components and
+// must not be expired. When the content is known, the Content-Digest field must match it if it is covered or present.
+// A keyid parameter must name a key of the keyring, without one every key is tried.
+func VerifyHTTPMessage(keyring *Keyring, message *HTTPMessage, label string, required []string) (*HTTPVerification, error) {
+	inputs, err := parseSFDictionary(strings.Join(message.Header.Values("Signature-Input"), ", "))
+	if err != nil {
+		return nil, fmt.Errorf("%w: Signature-Input: %v", ErrMalformedHTTPSignature, err)
+	}
+
+	signatures, err := parseSFDictionary(strings.Join(message.Header.Values("Signature"), ", "))
+	if err != nil {
+		return nil, fmt.Errorf("%w: Signature: %v", ErrMalformedHTTPSignature, err)
+	}
+
+	err = fmt.Errorf("%w: no signature %q", ErrMalformedHTTPSignature, label)
+	for _, input := range inputs {
+		if label != "" && input.Key != label {
+			continue
+		}
+
+		var verification *HTTPVerification
+		if verification, err = verifyHTTPSignature(keyring, message, input, signatures, required); err == nil {
+			return verification, nil
+		}
+	}
+	return nil, err
+}
+
+func verifyHTTPSignature(keyring *Keyring, message *HTTPMessage, input sfMember, signatures []sfMember, required []string) (*HTTPVerification, error) {
+	var signature []byte
+	for _, member := range signatures {
+		if member.Key == input.Key {
+			signature, _ = member.Value.([]byte)
+		}
+	}
+
+	if !input.IsList || signature == nil {
+		return nil, fmt.Errorf("%w: signature %q", ErrMalformedHTTPSignature, input.Key)
+	}
+
+	verification := &HTTPVerification{Label: input.Key}
+	for _, component := range input.InnerList {
+		name, ok := component.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: component %v", ErrMalformedHTTPSignature, component.Value)
+		}
+		verification.Components = append(verification.Components, name)
+	}
+
+	for _, component := range required {
+		if !contains(verification.Components, component) {
+			return nil, fmt.Errorf("%w: %q is not covered", ErrInvalidHTTPComponent, component)
+		}
+	}
+
+	var keyID, alg string
+	for _, parameter := range input.Parameters {
+		var ok bool
+		switch parameter.Key {
+		case "created", "expires":
+			var seconds int64
+			if seconds, ok = parameter.Value.(int64); ok && parameter.Key == "created" {
+				verification.Created = time.Unix(seconds, 0)
+			} else if ok {
+				verification.Expires = time.Unix(seconds, 0)
+			}
+		case "keyid":
+			keyID, ok = parameter.Value.(string)
+		case "alg":
+			alg, ok = parameter.Value.(string)
+		case "nonce":
+			verification.Nonce, ok = parameter.Value.(string)
+		case "tag":
+			verification.Tag, ok = parameter.Value.(string)
+		default:
+			ok = true
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("%w: parameter %s", ErrMalformedHTTPSignature, parameter.Key)
+		}
+	}
+
+	if !verification.Expires.IsZero() && time.Now().After(verification.Expires) {
+		return nil, errHTTPSignatureExpired
+	}
+
+	if message.Content != nil && (contains(verification.Components, _contentDigestField) || len(message.Header.Values(_contentDigestField)) != 0) {
+		if err := checkContentDigest(message.Header.Values(_contentDigestField), message.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	base, _, err := httpSignatureBase(message, input.InnerList, input.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := keyring.Keys()
+	if keyID != "" {
+		key, ok := keyring.Lookup(keyID)
+		if !ok {
+			return nil, fmt.Errorf("%w: %w %q", errHTTPSignatureMismatch, ErrUnknownKey, keyID)
+		}
+		candidates = []*Key{key}
+	}
+
+	for _, key := range candidates {
+		keyAlg, err := key.Algorithm.HTTPSignatureAlgorithm()
+		if err != nil || (alg != "" && alg != keyAlg) {
+			continue
+		}
+
+		if httpVerify(key, base, signature) {
+			verification.Key = key
+			return verification, nil
+		}
+	}
+	return nil, errHTTPSignatureMismatch
+}
+
+// httpSignatureBase returns the signature base of the components of message and the @signature-params value.
+func httpSignatureBase(message *HTTPMessage, components []sfItem, parameters []sfParameter) (string, string, error) {
+	var base strings.Builder
+	seen := map[string]bool{}
+	for _, component := range components {
+		name, ok := component.Value.(string)
+		if !ok || len(component.Parameters) != 0 {
+			return "", "", fmt.Errorf("%w: component parameters are not supported", ErrInvalidHTTPComponent)
+		}
+
+		if seen[name] {
+			return "", "", fmt.Errorf("%w: %q is covered twice", ErrInvalidHTTPComponent, name)
+		}
+		seen[name] = true
+
+		value, err := httpComponentValue(message, name)
+		if err != nil {
+			return "", "", err
+		}
+
+		if strings.ContainsAny(value, "\r\n") {
+			return "", "", fmt.Errorf("%w: %q has a line break", ErrInvalidHTTPComponent, name)
+		}
+		base.WriteString(`"` + name + `": ` + value + "\n")
+	}
+
+	signatureParams, err := serializeSFInnerList(components, parameters)
+	if err != nil {
+		return "", "", fmt.Errorf("%w: %v", ErrInvalidHTTPComponent, err)
+	}
+
+	base.WriteString(`"@signature-params": ` + signatureParams)
+	return base.String(), signatureParams, nil
+}
+
+// httpComponentValue derives the value of a component (RFC 9421 2.1 and 2.2).
+func httpComponentValue(message *HTTPMessage, name string) (string, error) {
+	if strings.HasPrefix(name, "@") {
+		target := message.TargetURI
+		if target == nil && name != "@method" && name != "@status" {
+			return "", fmt.Errorf("%w: %q of a message without a target URI", ErrInvalidHTTPComponent, name)
+		}
+
+		switch name {
+		case "@method":
+			if message.Method == "" {
+				return "", fmt.Errorf("%w: %q of a message without a method", ErrInvalidHTTPComponent, name)
+			}
+			return message.Method, nil
+		case "@target-uri":
+			return target.String(), nil
+		case "@authority":
+			return httpAuthority(target), nil
+		case "@scheme":
+			return strings.ToLower(target.Scheme), nil
+		case "@request-target":
+			return target.RequestURI(), nil
+		case "@path":
+			if path := target.EscapedPath(); path != "" {
+				return path, nil
+			}
+			return "/", nil
+		case "@query":
+			return "?" + target.RawQuery, nil
+		case "@status":
+			if message.Status < 100 || message.Status > 999 {
+				return "", fmt.Errorf("%w: %q of a message without a status", ErrInvalidHTTPComponent, name)
+			}
+			return fmt.Sprintf("%03d", message.Status), nil
+		default:
+			return "", fmt.Errorf("%w: unsupported derived component %q", ErrInvalidHTTPComponent, name)
+		}
+	}
+
+	if name == "" || strings.ToLower(name) != name || strings.ContainsAny(name, "\" \t:") {
+		return "", fmt.Errorf("%w: field name %q", ErrInvalidHTTPComponent, name)
+	}
+
+	values := message.Header.Values(name)
+	if len(values) == 0 {
+		return "", fmt.Errorf("%w: no %q field", ErrInvalidHTTPComponent, name)
+	}
+
+	trimmed := make([]string, len(values))
+	for i, value := range values {
+		trimmed[i] = strings.Trim(value, " \t")
+	}
+	return strings.Join(trimmed, ", "), nil
+}
+
+// httpAuthority is the lower case host of target with the port unless it is the default port of the scheme.
+func httpAuthority(target *url.URL) string {
+	host, port := strings.ToLower(target.Hostname()), target.Port()
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	scheme := strings.ToLower(target.Scheme)
+	if port == "" || (scheme == "http" && port == "80") || (scheme == "https" && port == "443") {
+		return host
+	}
+	return host + ":" + port
+}
+
+func httpSign(key *Key, base string) ([]byte, error) {
+	if key.Algorithm.isRSA() {
+		digest := sha512.Sum512([]byte(base))
+		return key.SignDigest(crypto.SHA512, digest[:])
+	}
+
+	signature, err := key.Sign([]byte(base))
+	if err != nil {
+		return nil, err
+	}
+	return key.Algorithm.rawSignature(signature)
+}
+
+func httpVerify(key *Key, base string, signature []byte) bool {
+	if key.Algorithm.isRSA() {
+		digest := sha512.Sum512([]byte(base))
+		return key.VerifyDigest(crypto.SHA512, digest[:], signature)
+	}
+
+	signature, err := key.Algorithm.asn1Signature(signature)
+	if err != nil {
+		return false
+	}
+	return key.Verify([]byte(base), signature)
+}
+
+// ContentDigest returns the RFC 9530 Content-Digest field value of content with SHA-256.
+func ContentDigest(content []byte) string {
+	digest := sha256.Sum256(content)
+	return "sha-256=:" + base64.StdEncoding.EncodeToString(digest[:]) + ":"
+}
+
+// checkContentDigest checks the SHA-256 and SHA-512 digests of a Content-Digest field, at least one of them must be
+// present.
+func checkContentDigest(values []string, content []byte) error {
+	digests, err := parseSFDictionary(strings.Join(values, ", "))
+	if err != nil {
+		return fmt.Errorf("%w: Content-Digest: %v", ErrMalformedHTTPSignature, err)
+	}
+
+	checked := false
+	for _, digest := range digests {
+		var computed []byte
+		switch digest.Key {
+		case "sha-256":
+			sum := sha256.Sum256(content)
+			computed = sum[:]
+		case "sha-512":
+			sum := sha512.Sum512(content)
+			computed = sum[:]
+		default:
+			continue
+		}
+
+		if value, ok := digest.Value.([]byte); !ok || !bytes.Equal(value, computed) {
+			return fmt.Errorf("%w: %s content digest", errHTTPSignatureMismatch, digest.Key)
+		}
+		checked = true
+	}
+
+	if !checked {
+		return fmt.Errorf("%w: no sha-256 or sha-512 content digest", ErrMalformedHTTPSignature)
+	}
+	return nil
+}
+
+func isSFKey(key string) bool {
+	p := &sfParser{input: key}
+	parsed, err := p.key()
+	return err == nil && parsed == key
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
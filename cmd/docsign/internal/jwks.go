@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+const (
+	JWKSPath = "/.well-known/jwks.json"
+
+	_jwksMaxAge = 300
+)
+
+// JWK is a public JSON Web Key (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NewJWK converts a public key into its JWK form.
+func NewJWK(id string, publicKey crypto.PublicKey) (JWK, error) {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Kid: id, Alg: "EdDSA", Use: "sig", Crv: "Ed25519", X: encode(key)}, nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		jwk := JWK{Kty: "EC", Kid: id, Use: "sig", X: encode(key.X.FillBytes(make([]byte, size))), Y: encode(key.Y.FillBytes(make([]byte, size)))}
+		switch key.Curve.Params().Name {
+		case "P-256":
+			jwk.Crv, jwk.Alg = "P-256", "ES256"
+		case "P-384":
+			jwk.Crv, jwk.Alg = "P-384", "ES384"
+		default:
+			return JWK{}, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, key.Curve.Params().Name)
+		}
+		return jwk, nil
+	case *rsa.PublicKey:
+		return JWK{Kty: "RSA", Kid: id, Alg: "PS256", Use: "sig", N: encode(key.N.Bytes()), E: encode(big.NewInt(int64(key.E)).Bytes())}, nil
+	default:
+		return JWK{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, publicKey)
+	}
+}
+
+// NewJWKS builds a key set of every active and retired key of the keyring.
+// ML-DSA and hybrid keys have no registered JWK form and are left out.
+func NewJWKS(keyring *Keyring) (*JWKS, error) {
+	keys := keyring.Keys()
+	jwks := &JWKS{Keys: make([]JWK, 0, len(keys))}
+	for _, key := range keys {
+		jwk, err := NewJWK(key.ID, key.PublicKey)
+		if errors.Is(err, ErrUnsupportedKey) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks, nil
+}
+
+// JWKSHandler serves the key set of keyring. The set is built on every request, so rotations are visible immediately.
+func JWKSHandler(keyring *Keyring) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		jwks, err := NewJWKS(keyring)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		body, err := json.Marshal(jwks)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		digest := sha256.Sum256(body)
+		etag := fmt.Sprintf(`"%s"`, base64.RawURLEncoding.EncodeToString(digest[:16]))
+
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", _jwksMaxAge))
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		_, _ = w.Write(body)
+	}
+}
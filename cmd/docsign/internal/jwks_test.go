@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWKSHandler(t *testing.T) {
+	t.Parallel()
+
+	oldKey, newKey := newTestKey(t), newTestKey(t)
+	keyring := NewKeyring(oldKey)
+
+	mux := runtime.NewServeMux()
+	assert.NoError(t, mux.HandlePath(http.MethodGet, JWKSPath, JWKSHandler(keyring)))
+
+	fetch := func(etag string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, JWKSPath, nil)
+		if etag != "" {
+			request.Header.Set("If-None-Match", etag)
+		}
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	response := fetch("")
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NotEmpty(t, response.Header().Get("Cache-Control"))
+
+	var jwks JWKS
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &jwks))
+	assert.Len(t, jwks.Keys, 1)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, oldKey.ID, jwks.Keys[0].Kid)
+	assert.Equal(t, base64.RawURLEncoding.EncodeToString(oldKey.PublicKey.(ed25519.PublicKey)), jwks.Keys[0].X)
+
+	etag := response.Header().Get("ETag")
+	assert.Equal(t, http.StatusNotModified, fetch(etag).Code)
+
+	keyring.Rotate(newKey)
+
+	response = fetch(etag)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &jwks))
+	assert.Len(t, jwks.Keys, 2)
+}
@@ -0,0 +1,282 @@
+package internal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// JWSSerialization selects one of the RFC 7515 serializations.
+type JWSSerialization int
+
+const (
+	JWSCompact JWSSerialization = iota
+	JWSFlattenedJSON
+	JWSGeneralJSON
+)
+
+var (
+	ErrMalformedJWS   = errors.New("malformed JWS")
+	ErrNoJWSAlgorithm = errors.New("no JWS algorithm")
+)
+
+var _jwsAlgorithms = map[Algorithm]string{
+	AlgorithmEd25519:          "EdDSA",
+	AlgorithmECDSAP256SHA256:  "ES256",
+	AlgorithmECDSAP384SHA384:  "ES384",
+	AlgorithmRSAPSS2048SHA256: "PS256",
+	AlgorithmRSAPSS3072SHA256: "PS256",
+	AlgorithmRSAPSS4096SHA256: "PS256",
+	AlgorithmMLDSA44:          "ML-DSA-44",
+	AlgorithmMLDSA65:          "ML-DSA-65",
+	AlgorithmMLDSA87:          "ML-DSA-87",
+}
+
+// JWSAlgorithm returns the "alg" header parameter of JWS signatures made with the algorithm. Hybrid signatures
+// have none.
+func (a Algorithm) JWSAlgorithm() (string, error) {
+	if name, ok := _jwsAlgorithms[a]; ok {
+		return name, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrNoJWSAlgorithm, a)
+}
+
+// JWSOptions tells how SignJWS serializes a signature.
+type JWSOptions struct {
+	Serialization JWSSerialization
+	// Detached leaves the payload out of the JWS (RFC 7515 appendix F).
+	Detached bool
+	// Unencoded signs the payload as is instead of its base64url form (RFC 7797, "b64": false). Unencoded
+	// payloads are always detached.
+	Unencoded bool
+	// Type and ContentType are the optional "typ" and "cty" header parameters.
+	Type        string
+	ContentType string
+}
+
+type jwsHeader struct {
+	Alg  string   `json:"alg,omitempty"`
+	Kid  string   `json:"kid,omitempty"`
+	Typ  string   `json:"typ,omitempty"`
+	Cty  string   `json:"cty,omitempty"`
+	B64  *bool    `json:"b64,omitempty"`
+	Crit []string `json:"crit,omitempty"`
+}
+
+type jwsSignature struct {
+	Protected string     `json:"protected,omitempty"`
+	Header    *jwsHeader `json:"header,omitempty"`
+	Signature string     `json:"signature"`
+}
+
+type jwsFlattened struct {
+	Payload *string `json:"payload,omitempty"`
+	jwsSignature
+}
+
+type jwsGeneral struct {
+	Payload    *string        `json:"payload,omitempty"`
+	Signatures []jwsSignature `json:"signatures"`
+}
+
+// jwsJSON accepts both JSON serializations.
+type jwsJSON struct {
+	Payload    *string        `json:"payload"`
+	Protected  string         `json:"protected"`
+	Header     *jwsHeader     `json:"header"`
+	Signature  string         `json:"signature"`
+	Signatures []jwsSignature `json:"signatures"`
+}
+
+// SignJWS signs payload with key and returns the JWS in the serialization chosen by options.
+// The protected header carries "alg" and "kid".
+func SignJWS(key *Key, payload []byte, options JWSOptions) (string, error) {
+	alg, err := key.Algorithm.JWSAlgorithm()
+	if err != nil {
+		return "", err
+	}
+
+	header := jwsHeader{Alg: alg, Kid: key.ID, Typ: options.Type, Cty: options.ContentType}
+	if options.Unencoded {
+		encoded := false
+		header.B64, header.Crit = &encoded, []string{"b64"}
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+
+	encode := base64.RawURLEncoding.EncodeToString
+	protected := encode(headerJSON)
+	encodedPayload := encode(payload)
+	signingPayload := encodedPayload
+	if options.Unencoded {
+		signingPayload = string(payload)
+	}
+
+	signature, err := key.Sign([]byte(protected + "." + signingPayload))
+	if err != nil {
+		return "", err
+	}
+
+	if signature, err = key.Algorithm.rawSignature(signature); err != nil {
+		return "", err
+	}
+
+	var embedded *string
+	if !options.Detached && !options.Unencoded {
+		embedded = &encodedPayload
+	}
+
+	signed := jwsSignature{Protected: protected, Signature: encode(signature)}
+	switch options.Serialization {
+	case JWSCompact:
+		compactPayload := ""
+		if embedded != nil {
+			compactPayload = *embedded
+		}
+		return signed.Protected + "." + compactPayload + "." + signed.Signature, nil
+	case JWSFlattenedJSON:
+		encoded, err := json.Marshal(jwsFlattened{Payload: embedded, jwsSignature: signed})
+		return string(encoded), err
+	case JWSGeneralJSON:
+		encoded, err := json.Marshal(jwsGeneral{Payload: embedded, Signatures: []jwsSignature{signed}})
+		return string(encoded), err
+	default:
+		return "", fmt.Errorf("unknown JWS serialization %d", options.Serialization)
+	}
+}
+
+// VerifyJWS checks a JWS in any serialization against the keys of keyring. detachedPayload is used when the JWS
+// carries no payload. A JSON serialized JWS with several signatures is valid when any of them verifies.
+// The key of the valid signature and the payload are returned.
+func VerifyJWS(keyring *Keyring, jws string, detachedPayload []byte) (*Key, []byte, error) {
+	var (
+		payload    *string
+		signatures []jwsSignature
+	)
+
+	jws = strings.TrimSpace(jws)
+	if strings.HasPrefix(jws, "{") {
+		var parsed jwsJSON
+		if err := json.Unmarshal([]byte(jws), &parsed); err != nil {
+			return nil, nil, fmt.Errorf("%w: %v", ErrMalformedJWS, err)
+		}
+
+		payload, signatures = parsed.Payload, parsed.Signatures
+		if len(signatures) == 0 {
+			signatures = []jwsSignature{{Protected: parsed.Protected, Header: parsed.Header, Signature: parsed.Signature}}
+		}
+	} else {
+		parts := strings.Split(jws, ".")
+		if len(parts) != 3 {
+			return nil, nil, fmt.Errorf("%w: %d compact parts", ErrMalformedJWS, len(parts))
+		}
+
+		if parts[1] != "" {
+			payload = &parts[1]
+		}
+		signatures = []jwsSignature{{Protected: parts[0], Signature: parts[2]}}
+	}
+
+	err := fmt.Errorf("%w: no signature", ErrMalformedJWS)
+	for _, signature := range signatures {
+		var (
+			key     *Key
+			content []byte
+		)
+		if key, content, err = verifyJWSSignature(keyring, signature, payload, detachedPayload); err == nil {
+			return key, content, nil
+		}
+	}
+	return nil, nil, err
+}
+
+var errJWSMismatch = errors.New("JWS signature mismatch")
+
+func verifyJWSSignature(keyring *Keyring, signature jwsSignature, payload *string, detachedPayload []byte) (*Key, []byte, error) {
+	decode := base64.RawURLEncoding.DecodeString
+
+	protectedJSON, err := decode(signature.Protected)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: protected header: %v", ErrMalformedJWS, err)
+	}
+
+	var header jwsHeader
+	if err := json.Unmarshal(protectedJSON, &header); err != nil {
+		return nil, nil, fmt.Errorf("%w: protected header: %v", ErrMalformedJWS, err)
+	}
+
+	// "b64" and "crit" are protected only, other parameters may be unprotected as well.
+	if unprotected := signature.Header; unprotected != nil {
+		if unprotected.B64 != nil || unprotected.Crit != nil {
+			return nil, nil, fmt.Errorf("%w: unprotected b64 or crit", ErrMalformedJWS)
+		}
+		if header.Alg == "" {
+			header.Alg = unprotected.Alg
+		}
+		if header.Kid == "" {
+			header.Kid = unprotected.Kid
+		}
+	}
+
+	for _, name := range header.Crit {
+		if name != "b64" || header.B64 == nil {
+			return nil, nil, fmt.Errorf("%w: unsupported critical header %q", ErrMalformedJWS, name)
+		}
+	}
+	encoded := header.B64 == nil || *header.B64
+
+	var content []byte
+	signingPayload := ""
+	switch {
+	case payload == nil:
+		content = detachedPayload
+		signingPayload = string(detachedPayload)
+		if encoded {
+			signingPayload = base64.RawURLEncoding.EncodeToString(detachedPayload)
+		}
+	case encoded:
+		if content, err = decode(*payload); err != nil {
+			return nil, nil, fmt.Errorf("%w: payload: %v", ErrMalformedJWS, err)
+		}
+		signingPayload = *payload
+	default:
+		content, signingPayload = []byte(*payload), *payload
+	}
+
+	rawSignature, err := decode(signature.Signature)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: signature: %v", ErrMalformedJWS, err)
+	}
+
+	// Without a "kid" any key of the keyring may have made the signature.
+	keys := keyring.Keys()
+	if header.Kid != "" {
+		key, ok := keyring.Lookup(header.Kid)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %w %q", errJWSMismatch, ErrUnknownKey, header.Kid)
+		}
+		keys = []*Key{key}
+	}
+
+	signingInput := []byte(signature.Protected + "." + signingPayload)
+	for _, key := range keys {
+		if alg, err := key.Algorithm.JWSAlgorithm(); err != nil || alg != header.Alg {
+			continue
+		}
+
+		asn1Signature, err := key.Algorithm.asn1Signature(rawSignature)
+		if err != nil {
+			continue
+		}
+
+		if key.Verify(signingInput, asn1Signature) {
+			return key, content, nil
+		}
+	}
+	return nil, nil, errJWSMismatch
+}
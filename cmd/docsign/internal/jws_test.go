@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignJWS(t *testing.T) {
+	t.Parallel()
+
+	keys := map[Algorithm]*Key{}
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256, AlgorithmMLDSA44} {
+		keys[algorithm] = newTestAlgorithmKey(t, algorithm)
+	}
+
+	tests := []struct {
+		name    string
+		options JWSOptions
+	}{
+		{name: "Case #1", options: JWSOptions{Serialization: JWSCompact}},
+		{name: "Case #2", options: JWSOptions{Serialization: JWSCompact, Detached: true}},
+		{name: "Case #3", options: JWSOptions{Serialization: JWSCompact, Unencoded: true}},
+		{name: "Case #4", options: JWSOptions{Serialization: JWSFlattenedJSON, Type: "JOSE", ContentType: "json"}},
+		{name: "Case #5", options: JWSOptions{Serialization: JWSFlattenedJSON, Unencoded: true}},
+		{name: "Case #6", options: JWSOptions{Serialization: JWSGeneralJSON}},
+		{name: "Case #7", options: JWSOptions{Serialization: JWSGeneralJSON, Detached: true}},
+	}
+
+	payload := []byte(`{"document": "payload.with.dots"}`)
+
+	for algorithm, key := range keys {
+		keyring := NewKeyring(key)
+
+		for _, tt := range tests {
+			t.Run(algorithm.String()+" "+tt.name, func(t *testing.T) {
+				jws, err := SignJWS(key, payload, tt.options)
+				assert.NoError(t, err)
+
+				var detached []byte
+				if tt.options.Detached || tt.options.Unencoded {
+					detached = payload
+					assert.NotContains(t, jws, base64.RawURLEncoding.EncodeToString(payload))
+				}
+
+				verifiedKey, verifiedPayload, err := VerifyJWS(keyring, jws, detached)
+				assert.NoError(t, err)
+				assert.Equal(t, key.ID, verifiedKey.ID)
+				assert.Equal(t, payload, verifiedPayload)
+
+				if detached != nil {
+					_, _, err = VerifyJWS(keyring, jws, payload[1:])
+					assert.Error(t, err)
+				}
+
+				// Still valid once the key is retired.
+				keyring := NewKeyring(newTestKey(t), key.retire())
+				_, _, err = VerifyJWS(keyring, jws, detached)
+				assert.NoError(t, err)
+
+				_, _, err = VerifyJWS(NewKeyring(newTestAlgorithmKey(t, algorithm)), jws, detached)
+				assert.Error(t, err)
+			})
+		}
+	}
+
+	_, err := SignJWS(newTestAlgorithmKey(t, AlgorithmEd25519MLDSA65), payload, JWSOptions{})
+	assert.ErrorIs(t, err, ErrNoJWSAlgorithm)
+}
+
+func TestVerifyJWS_Malformed(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	keyring := NewKeyring(key)
+
+	compact, err := SignJWS(key, []byte("payload"), JWSOptions{})
+	assert.NoError(t, err)
+	parts := strings.Split(compact, ".")
+
+	general, err := SignJWS(key, []byte("payload"), JWSOptions{Serialization: JWSGeneralJSON})
+	assert.NoError(t, err)
+
+	header := func(header string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(header))
+	}
+
+	tests := map[string]string{
+		"Case #1":  "",
+		"Case #2":  parts[0] + "." + parts[1],
+		"Case #3":  parts[0] + "." + parts[1] + "." + parts[2] + ".",
+		"Case #4":  "!." + parts[1] + "." + parts[2],
+		"Case #5":  parts[0] + ".!." + parts[2],
+		"Case #6":  parts[0] + "." + parts[1] + ".!",
+		"Case #7":  header(`{"alg":"ES256","kid":"`+key.ID+`"}`) + "." + parts[1] + "." + parts[2],
+		"Case #8":  header(`{"alg":"EdDSA","kid":"unknown"}`) + "." + parts[1] + "." + parts[2],
+		"Case #9":  header(`{"alg":"EdDSA","crit":["exp"]}`) + "." + parts[1] + "." + parts[2],
+		"Case #10": header(`{"alg":"none"}`) + "." + parts[1] + ".",
+		"Case #11": "{",
+		"Case #12": `{"signatures": []}`,
+		"Case #13": strings.Replace(general, parts[2], parts[2][1:], 1),
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			_, _, err := VerifyJWS(keyring, tt, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestVerifyJWS_GeneralJSON(t *testing.T) {
+	t.Parallel()
+
+	key, other := newTestKey(t), newTestKey(t)
+
+	first, err := SignJWS(other, []byte("payload"), JWSOptions{Serialization: JWSGeneralJSON})
+	assert.NoError(t, err)
+	second, err := SignJWS(key, []byte("payload"), JWSOptions{Serialization: JWSFlattenedJSON})
+	assert.NoError(t, err)
+
+	var jws jwsGeneral
+	assert.NoError(t, json.Unmarshal([]byte(first), &jws))
+	var flattened jwsFlattened
+	assert.NoError(t, json.Unmarshal([]byte(second), &flattened))
+	jws.Signatures = append(jws.Signatures, flattened.jwsSignature)
+
+	encoded, err := json.Marshal(jws)
+	assert.NoError(t, err)
+
+	// Only the second signature is made by a key of the keyring.
+	verifiedKey, payload, err := VerifyJWS(NewKeyring(key), string(encoded), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, key.ID, verifiedKey.ID)
+	assert.Equal(t, []byte("payload"), payload)
+}
+
+// RFC 8037 appendix A.4, the JWS has no "kid".
+func TestVerifyJWS_RFC8037(t *testing.T) {
+	t.Parallel()
+
+	seed, err := base64.RawURLEncoding.DecodeString("nWGxne_9WmC6hEr0kuwsxERJxWl7MmkZcDusAxyuf2A")
+	assert.NoError(t, err)
+
+	key, err := NewActiveKey(ed25519.NewKeyFromSeed(seed), time.Now())
+	assert.NoError(t, err)
+
+	jws := "eyJhbGciOiJFZERTQSJ9.RXhhbXBsZSBvZiBFZDI1NTE5IHNpZ25pbmc." +
+		"hgyY0il_MGCjP0JzlnLWG1PPOt7-09PGcvMg3AIbQR6dWbhijcNR4ki4iylGjg5BhVsPt9g7sVvpAr_MuM0KAg"
+
+	verifiedKey, payload, err := VerifyJWS(NewKeyring(newTestKey(t), key.retire()), jws, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, key.ID, verifiedKey.ID)
+	assert.Equal(t, "Example of Ed25519 signing", string(payload))
+}
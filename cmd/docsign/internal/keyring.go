@@ -0,0 +1,282 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+type KeyStatus int
+
+const (
+	KeyStatusActive KeyStatus = iota
+	KeyStatusRetired
+	KeyStatusCosigning
+)
+
+const _keyIDSize = 8
+
+func (s KeyStatus) String() string {
+	switch s {
+	case KeyStatusActive:
+		return "active"
+	case KeyStatusRetired:
+		return "retired"
+	case KeyStatusCosigning:
+		return "cosigning"
+	default:
+		return "unknown"
+	}
+}
+
+// Key is an immutable snapshot of a keyring entry. Retired keys carry no signer.
+type Key struct {
+	ID        string
+	Algorithm Algorithm
+	Signer    crypto.Signer
+	PublicKey crypto.PublicKey
+	Status    KeyStatus
+	CreatedAt time.Time
+	// Certificates is the X.509 certificate of the key followed by its chain, empty when none is configured.
+	Certificates []*x509.Certificate
+}
+
+// KeyID returns a stable identifier of a public key: hex encoded prefix of the SHA-256 digest
+// of the raw Ed25519 key or of the DER encoded SubjectPublicKeyInfo of any other key.
+func KeyID(publicKey crypto.PublicKey) string {
+	encoded, ok := publicKey.(ed25519.PublicKey)
+	if !ok {
+		var err error
+		if encoded, err = marshalPKIXPublicKey(publicKey); err != nil {
+			return ""
+		}
+	}
+
+	digest := sha256.Sum256(encoded)
+	return hex.EncodeToString(digest[:_keyIDSize])
+}
+
+// NewActiveKey builds a signing key entry.
+func NewActiveKey(signer crypto.Signer, createdAt time.Time) (*Key, error) {
+	key, err := NewRetiredKey(signer.Public(), createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Signer, key.Status = signer, KeyStatusActive
+	return key, nil
+}
+
+// NewRetiredKey builds a verify-only key entry.
+func NewRetiredKey(publicKey crypto.PublicKey, createdAt time.Time) (*Key, error) {
+	algorithm, err := AlgorithmOf(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		ID:        KeyID(publicKey),
+		Algorithm: algorithm,
+		PublicKey: publicKey,
+		Status:    KeyStatusRetired,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// Sign signs data with the key. Only active keys can sign.
+func (k *Key) Sign(data []byte) ([]byte, error) {
+	if k.Signer == nil {
+		return nil, fmt.Errorf("key %s is %s", k.ID, k.Status)
+	}
+	return k.Algorithm.Sign(k.Signer, data)
+}
+
+// Verify checks a signature of data made by the key.
+func (k *Key) Verify(data, signature []byte) bool {
+	return k.Algorithm.Verify(k.PublicKey, data, signature)
+}
+
+// SignDigest signs a digest made with hash. Only active keys can sign.
+func (k *Key) SignDigest(hash crypto.Hash, digest []byte) ([]byte, error) {
+	if k.Signer == nil {
+		return nil, fmt.Errorf("key %s is %s", k.ID, k.Status)
+	}
+	return k.Algorithm.SignDigest(k.Signer, hash, digest)
+}
+
+// VerifyDigest checks a signature of a digest made by the key.
+func (k *Key) VerifyDigest(hash crypto.Hash, digest, signature []byte) bool {
+	return k.Algorithm.VerifyDigest(k.PublicKey, hash, digest, signature)
+}
+
+// WithCertificates returns a copy of the key with a certificate chain. The first certificate must hold the public key.
+func (k *Key) WithCertificates(certificates []*x509.Certificate) (*Key, error) {
+	if len(certificates) == 0 {
+		return nil, ErrNoCertificate
+	}
+
+	spki, err := marshalPKIXPublicKey(k.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	certified, ok := certificates[0].PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !bytes.Equal(spki, certificates[0].RawSubjectPublicKeyInfo) && !(ok && certified.Equal(k.PublicKey)) {
+		return nil, fmt.Errorf("%w: key %s", ErrCertificateMismatch, k.ID)
+	}
+
+	key := *k
+	key.Certificates = certificates
+	return &key, nil
+}
+
+func (k *Key) retire() *Key {
+	retired := *k
+	retired.Signer, retired.Status = nil, KeyStatusRetired
+	return &retired
+}
+
+// Keyring holds one active signing key and any number of verify-only retired keys.
+// It is safe for concurrent use.
+type Keyring struct {
+	mu      sync.RWMutex
+	active  *Key
+	keys    map[string]*Key
+	revoked map[string]bool
+}
+
+func NewKeyring(active *Key, retired ...*Key) *Keyring {
+	keyring := &Keyring{keys: make(map[string]*Key), revoked: make(map[string]bool)}
+	keyring.Rotate(active, retired...)
+	return keyring
+}
+
+// Active returns the key used for new signatures.
+func (k *Keyring) Active() *Key {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.active
+}
+
+// Lookup finds a key by its id. An empty id refers to the active key.
+func (k *Keyring) Lookup(id string) (*Key, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if id == "" {
+		return k.active, true
+	}
+
+	key, ok := k.keys[id]
+	return key, ok
+}
+
+// Signer finds a key which can sign: the active key for an empty id, otherwise the active or a co-signing key.
+func (k *Keyring) Signer(id string) (*Key, bool) {
+	key, ok := k.Lookup(id)
+	return key, ok && key.Signer != nil
+}
+
+// Keys returns every known key ordered by creation time, oldest first.
+func (k *Keyring) Keys() []*Key {
+	k.mu.RLock()
+	keys := make([]*Key, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, key)
+	}
+	k.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].ID < keys[j].ID
+		}
+		return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+	})
+	return keys
+}
+
+// Rotate makes active the signing key. The previous active key, if it differs, stays available for verification.
+// Retired keys are added unless they are already known.
+func (k *Keyring) Rotate(active *Key, retired ...*Key) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.active != nil && k.active.ID != active.ID {
+		k.keys[k.active.ID] = k.active.retire()
+	}
+
+	for _, key := range retired {
+		if _, ok := k.keys[key.ID]; ok || key.ID == active.ID || k.revoked[key.ID] {
+			continue
+		}
+		k.keys[key.ID] = key.retire()
+	}
+
+	k.active = active
+	k.keys[active.ID] = active
+}
+
+// SetCosigners makes cosigners additional signing keys next to the active key, used by multi-signer formats like
+// COSE_Sign. They are listed with KeyStatusCosigning. Previous co-signing keys which are not in cosigners stay
+// available for verification.
+func (k *Keyring) SetCosigners(cosigners ...*Key) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	current := make(map[string]bool, len(cosigners))
+	for _, key := range cosigners {
+		current[key.ID] = true
+	}
+
+	for id, key := range k.keys {
+		if key.Status == KeyStatusCosigning && !current[id] {
+			k.keys[id] = key.retire()
+		}
+	}
+
+	for _, key := range cosigners {
+		if key.ID != k.active.ID && !k.revoked[key.ID] {
+			cosigner := *key
+			cosigner.Status = KeyStatusCosigning
+			k.keys[key.ID] = &cosigner
+		}
+	}
+}
+
+// Revoke removes keys from the keyring for good: signatures of revoked keys no longer verify and the keys are not
+// added again by Rotate or SetCosigners. The active key cannot be revoked.
+func (k *Keyring) Revoke(ids ...string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for _, id := range ids {
+		if id == k.active.ID {
+			return fmt.Errorf("key %s is active", id)
+		}
+	}
+
+	for _, id := range ids {
+		k.revoked[id] = true
+		delete(k.keys, id)
+	}
+	return nil
+}
+
+// ParseKeyIDs splits a comma separated list of key ids.
+func ParseKeyIDs(ids string) []string {
+	return splitNames(ids)
+}
+
+// Revoked tells whether the key id is revoked.
+func (k *Keyring) Revoked(id string) bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.revoked[id]
+}
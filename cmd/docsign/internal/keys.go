@@ -0,0 +1,202 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	_privateKeyPemType  = "PRIVATE KEY"
+	_publicKeyPemType   = "PUBLIC KEY"
+	_certificatePemType = "CERTIFICATE"
+	_privateKeyFileMode = 0o600
+)
+
+var (
+	ErrNoPemBlock     = errors.New("no PEM block found")
+	ErrUnexpectedPem  = errors.New("unexpected PEM block type")
+	ErrUnsupportedKey = errors.New("unsupported private key type")
+
+	ErrCertificateMismatch = errors.New("certificate does not match the key")
+)
+
+// LoadPrivateKey reads a PKCS#8 PEM encoded private key from path and derives its public key.
+func LoadPrivateKey(path string) (crypto.Signer, crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read key file %s: %w", path, err)
+	}
+
+	privateKey, err := ParsePrivateKey(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse key file %s: %w", path, err)
+	}
+
+	return privateKey, privateKey.Public(), nil
+}
+
+// ParsePrivateKey decodes a PKCS#8 PEM encoded private key of any supported algorithm.
+func ParsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrNoPemBlock
+	}
+
+	if block.Type != _privateKeyPemType {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedPem, block.Type)
+	}
+
+	return parsePKCS8PrivateKey(block.Bytes)
+}
+
+func parsePKCS8PrivateKey(der []byte) (crypto.Signer, error) {
+	key, err := parsePKCS8(der)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+
+	if _, err := AlgorithmOf(privateKey.Public()); err != nil {
+		return nil, err
+	}
+
+	return privateKey, nil
+}
+
+// ParsePublicKey decodes a PEM encoded key. Private keys are accepted as well, only their public part is returned.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrNoPemBlock
+	}
+
+	switch block.Type {
+	case _privateKeyPemType:
+		privateKey, err := ParsePrivateKey(data)
+		if err != nil {
+			return nil, err
+		}
+		return privateKey.Public(), nil
+	case _publicKeyPemType:
+		publicKey, err := parsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := AlgorithmOf(publicKey); err != nil {
+			return nil, err
+		}
+		return publicKey, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedPem, block.Type)
+	}
+}
+
+// LoadCertificates reads the PEM encoded X.509 certificates of path, the certificate of a key followed by its chain.
+func LoadCertificates(path string) ([]*x509.Certificate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read certificate file %s: %w", path, err)
+	}
+
+	certificates, err := ParseCertificates(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse certificate file %s: %w", path, err)
+	}
+	return certificates, nil
+}
+
+// ParseCertificates decodes every PEM encoded X.509 certificate of data.
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+	for {
+		var block *pem.Block
+		if block, data = pem.Decode(data); block == nil {
+			break
+		}
+
+		if block.Type != _certificatePemType {
+			return nil, fmt.Errorf("%w: %s", ErrUnexpectedPem, block.Type)
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		return nil, ErrNoPemBlock
+	}
+	return certificates, nil
+}
+
+// MarshalPrivateKey encodes a private key as a PKCS#8 PEM block.
+func MarshalPrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	der, err := marshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: _privateKeyPemType, Bytes: der}), nil
+}
+
+// MarshalPublicKey encodes a public key as a PEM encoded SubjectPublicKeyInfo.
+func MarshalPublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	der, err := marshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: _publicKeyPemType, Bytes: der}), nil
+}
+
+// GenerateKeyFile creates a new key of the algorithm and writes it to path readable by the owner only.
+// An existing file is never overwritten.
+func GenerateKeyFile(path string, algorithm Algorithm) (crypto.PublicKey, error) {
+	privateKey, err := algorithm.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := MarshalPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeKeyFile(path, data); err != nil {
+		return nil, err
+	}
+
+	return privateKey.Public(), nil
+}
+
+// writeKeyFile creates path readable by the owner only and fails if it already exists.
+func writeKeyFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, _privateKeyFileMode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+
+	return nil
+}
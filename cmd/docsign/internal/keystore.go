@@ -0,0 +1,241 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	KeyStoreFile      = "file"
+	KeyStoreEncrypted = "encrypted"
+	KeyStorePKCS11    = "pkcs11"
+)
+
+var ErrUnknownKeyStore = errors.New("unknown keystore backend")
+
+// KeyStore provides the active signing key of GrpcDocSignServer.
+type KeyStore interface {
+	// Load returns the signing key. It is called at startup and on every reload.
+	Load() (*Key, error)
+	// Close releases resources held by the backend.
+	Close() error
+}
+
+// KeyStoreConfig selects and configures a KeyStore backend.
+type KeyStoreConfig struct {
+	// Backend is one of KeyStoreFile, KeyStoreEncrypted or KeyStorePKCS11.
+	Backend string
+	// Path of the key file for the file and encrypted backends.
+	Path string
+	// Passphrase unlocks an encrypted key file.
+	Passphrase []byte
+	PKCS11     PKCS11Config
+}
+
+// PKCS11Config locates a private key on a PKCS#11 token.
+type PKCS11Config struct {
+	// Module is the path of the PKCS#11 library, e.g. /usr/lib/softhsm/libsofthsm2.so.
+	Module     string
+	TokenLabel string
+	PIN        string
+	// KeyLabel is the CKA_LABEL of the private and public key objects.
+	KeyLabel string
+}
+
+// NewKeyStore creates the backend chosen by config.
+func NewKeyStore(config KeyStoreConfig) (KeyStore, error) {
+	switch config.Backend {
+	case "", KeyStoreFile:
+		return &FileKeyStore{Path: config.Path}, nil
+	case KeyStoreEncrypted:
+		return &EncryptedFileKeyStore{Path: config.Path, Passphrase: config.Passphrase}, nil
+	case KeyStorePKCS11:
+		return NewPKCS11KeyStore(config.PKCS11)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeyStore, config.Backend)
+	}
+}
+
+// FileKeyStore reads a plain PKCS#8 PEM encoded key.
+type FileKeyStore struct {
+	Path string
+}
+
+func (s *FileKeyStore) Load() (*Key, error) {
+	privateKey, _, err := LoadPrivateKey(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewActiveKey(privateKey, info.ModTime())
+}
+
+func (s *FileKeyStore) Close() error {
+	return nil
+}
+
+// LoadKeyring builds a keyring from the active key of store and a directory of retired keys.
+// retiredDir may be empty.
+func LoadKeyring(store KeyStore, retiredDir string) (*Keyring, error) {
+	active, retired, err := LoadKeys(store, retiredDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeyring(active, retired...), nil
+}
+
+// LoadKeys reads the active signing key and every retired key, see LoadKeyring.
+func LoadKeys(store KeyStore, retiredDir string) (*Key, []*Key, error) {
+	active, err := store.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	retired, err := LoadRetiredKeys(retiredDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return active, retired, nil
+}
+
+// AttachCertificates returns key with the certificate chain read from path, see Key.WithCertificates.
+// key is returned as is when path is empty.
+func AttachCertificates(key *Key, path string) (*Key, error) {
+	if path == "" {
+		return key, nil
+	}
+
+	certificates, err := LoadCertificates(path)
+	if err != nil {
+		return nil, err
+	}
+
+	certified, err := key.WithCertificates(certificates)
+	if err != nil {
+		return nil, fmt.Errorf("certificate file %s: %w", path, err)
+	}
+	return certified, nil
+}
+
+// LoadAuthorityKey reads the PKCS#8 PEM encoded key of an authority which signs on its own behalf, e.g. the
+// certificate authority, together with its certificate chain, see AttachCertificates. It returns nil when keyPath is
+// empty.
+func LoadAuthorityKey(keyPath, certificatePath string) (*Key, error) {
+	if keyPath == "" {
+		return nil, nil
+	}
+
+	if certificatePath == "" {
+		return nil, fmt.Errorf("%w: key file %s", ErrNoCertificate, keyPath)
+	}
+
+	privateKey, _, err := LoadPrivateKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := NewActiveKey(privateKey, info.ModTime())
+	if err != nil {
+		return nil, fmt.Errorf("parse key file %s: %w", keyPath, err)
+	}
+	return AttachCertificates(key, certificatePath)
+}
+
+// LoadCosigningKeys reads every PKCS#8 PEM encoded private key of dir as an additional signing key, see
+// Keyring.SetCosigners. The modification time of a file is used as the creation time of its key.
+func LoadCosigningKeys(dir string) ([]*Key, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read co-signing keys %s: %w", dir, err)
+	}
+
+	cosigners := make([]*Key, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		privateKey, _, err := LoadPrivateKey(path)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := NewActiveKey(privateKey, info.ModTime())
+		if err != nil {
+			return nil, fmt.Errorf("parse key file %s: %w", path, err)
+		}
+
+		cosigners = append(cosigners, key)
+	}
+
+	return cosigners, nil
+}
+
+// LoadRetiredKeys reads every PEM encoded key of dir as a verify-only key.
+// The modification time of a file is used as the creation time of its key.
+func LoadRetiredKeys(dir string) ([]*Key, error) {
+	if dir == "" {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read retired keys %s: %w", dir, err)
+	}
+
+	retired := make([]*Key, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read key file %s: %w", path, err)
+		}
+
+		publicKey, err := ParsePublicKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse key file %s: %w", path, err)
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := NewRetiredKey(publicKey, info.ModTime())
+		if err != nil {
+			return nil, fmt.Errorf("parse key file %s: %w", path, err)
+		}
+
+		retired = append(retired, key)
+	}
+
+	return retired, nil
+}
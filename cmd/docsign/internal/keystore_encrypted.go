@@ -0,0 +1,324 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Encrypted key file layout, every integer is big endian:
+//
+//	magic    [4]byte "DSKS"
+//	version  uint8
+//	kdf      uint8
+//	kdf parameters, see EncryptedKeyHeader
+//	salt     [16]byte
+//	nonce    [12]byte
+//	sealed   AES-256-GCM(PKCS#8 DER), the header is the additional data
+//
+// The whole file is wrapped into a PEM block.
+const (
+	_encryptedKeyPemType = "DOCSIGN ENCRYPTED PRIVATE KEY"
+	_encryptedKeyMagic   = "DSKS"
+	_encryptedKeyVersion = 1
+
+	_saltSize = 16
+	_aeadKey  = 32
+
+	// Upper bounds of the argon2id memory, in KiB, and passes accepted from a key file.
+	_maxKDFMemory = 4 * 1024 * 1024
+	_maxKDFTime   = 16
+)
+
+type KDF uint8
+
+const (
+	KDFArgon2id KDF = 1
+)
+
+func (k KDF) String() string {
+	switch k {
+	case KDFArgon2id:
+		return "argon2id"
+	default:
+		return fmt.Sprintf("kdf(%d)", uint8(k))
+	}
+}
+
+var (
+	ErrBadEncryptedKey = errors.New("malformed encrypted key")
+	ErrBadPassphrase   = errors.New("wrong passphrase or corrupted key")
+)
+
+// EncryptedKeyHeader is the unencrypted part of an encrypted key file.
+type EncryptedKeyHeader struct {
+	Version uint8
+	KDF     KDF
+	// Argon2id parameters, Memory is in KiB.
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	Salt    [_saltSize]byte
+	Nonce   [12]byte
+}
+
+// DefaultEncryptedKeyHeader returns a header with fresh salt and nonce and the RFC 9106 second recommended argon2id parameters.
+func DefaultEncryptedKeyHeader() (*EncryptedKeyHeader, error) {
+	header := &EncryptedKeyHeader{
+		Version: _encryptedKeyVersion,
+		KDF:     KDFArgon2id,
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+
+	if _, err := rand.Read(header.Salt[:]); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(header.Nonce[:]); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (h *EncryptedKeyHeader) marshal() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(_encryptedKeyMagic)
+	buf.WriteByte(h.Version)
+	buf.WriteByte(byte(h.KDF))
+	_ = binary.Write(&buf, binary.BigEndian, h.Time)
+	_ = binary.Write(&buf, binary.BigEndian, h.Memory)
+	buf.WriteByte(h.Threads)
+	buf.Write(h.Salt[:])
+	buf.Write(h.Nonce[:])
+	return buf.Bytes()
+}
+
+func (h *EncryptedKeyHeader) unmarshal(data []byte) ([]byte, error) {
+	reader := bytes.NewReader(data)
+
+	magic := make([]byte, len(_encryptedKeyMagic))
+	if _, err := reader.Read(magic); err != nil || string(magic) != _encryptedKeyMagic {
+		return nil, fmt.Errorf("%w: bad magic", ErrBadEncryptedKey)
+	}
+
+	fields := []any{&h.Version, &h.KDF, &h.Time, &h.Memory, &h.Threads, &h.Salt, &h.Nonce}
+	for _, field := range fields {
+		if err := binary.Read(reader, binary.BigEndian, field); err != nil {
+			return nil, fmt.Errorf("%w: truncated header", ErrBadEncryptedKey)
+		}
+	}
+
+	if h.Version != _encryptedKeyVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrBadEncryptedKey, h.Version)
+	}
+
+	if h.KDF != KDFArgon2id {
+		return nil, fmt.Errorf("%w: unsupported %s", ErrBadEncryptedKey, h.KDF)
+	}
+
+	if h.Time == 0 || h.Threads == 0 || h.Time > _maxKDFTime || h.Memory > _maxKDFMemory {
+		return nil, fmt.Errorf("%w: bad %s parameters", ErrBadEncryptedKey, h.KDF)
+	}
+
+	return data[len(data)-reader.Len():], nil
+}
+
+func (h *EncryptedKeyHeader) aead(passphrase []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey(passphrase, h.Salt[:], h.Time, h.Memory, h.Threads, _aeadKey)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// EncryptPrivateKey seals privateKey with a key derived from passphrase and returns the PEM encoded result.
+func EncryptPrivateKey(privateKey crypto.Signer, passphrase []byte) ([]byte, error) {
+	header, err := DefaultEncryptedKeyHeader()
+	if err != nil {
+		return nil, err
+	}
+	return encryptPrivateKey(privateKey, passphrase, header)
+}
+
+func encryptPrivateKey(privateKey crypto.Signer, passphrase []byte, header *EncryptedKeyHeader) ([]byte, error) {
+	der, err := marshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := header.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := header.marshal()
+	sealed := aead.Seal(prefix, header.Nonce[:], der, prefix)
+	return pem.EncodeToMemory(&pem.Block{Type: _encryptedKeyPemType, Bytes: sealed}), nil
+}
+
+// ParseEncryptedKeyHeader returns the header of a PEM encoded encrypted key without decrypting it.
+func ParseEncryptedKeyHeader(data []byte) (*EncryptedKeyHeader, error) {
+	header, _, _, err := decodeEncryptedKey(data)
+	return header, err
+}
+
+// ReadEncryptedKeyHeader returns the header of an encrypted key file without decrypting it.
+func ReadEncryptedKeyHeader(path string) (*EncryptedKeyHeader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEncryptedKeyHeader(data)
+}
+
+// decodeEncryptedKey splits an encrypted key into its parsed header, raw header bytes and sealed key.
+func decodeEncryptedKey(data []byte) (*EncryptedKeyHeader, []byte, []byte, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, nil, ErrNoPemBlock
+	}
+
+	if block.Type != _encryptedKeyPemType {
+		return nil, nil, nil, fmt.Errorf("%w: %s", ErrUnexpectedPem, block.Type)
+	}
+
+	header := &EncryptedKeyHeader{}
+	sealed, err := header.unmarshal(block.Bytes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return header, block.Bytes[:len(block.Bytes)-len(sealed)], sealed, nil
+}
+
+// DecryptPrivateKey opens a PEM encoded key sealed by EncryptPrivateKey.
+func DecryptPrivateKey(data, passphrase []byte) (crypto.Signer, error) {
+	header, prefix, sealed, err := decodeEncryptedKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := header.aead(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := aead.Open(nil, header.Nonce[:], sealed, prefix)
+	if err != nil {
+		return nil, ErrBadPassphrase
+	}
+
+	privateKey, err := parsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadEncryptedKey, err)
+	}
+
+	return privateKey, nil
+}
+
+// CreateEncryptedKeyFile generates a new key of the algorithm and writes it sealed with passphrase to path.
+// An existing file is never overwritten.
+func CreateEncryptedKeyFile(path string, algorithm Algorithm, passphrase []byte) (crypto.PublicKey, error) {
+	privateKey, err := algorithm.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey.Public(), ImportEncryptedKeyFile(path, privateKey, passphrase)
+}
+
+// ImportEncryptedKeyFile writes privateKey sealed with passphrase to path.
+// An existing file is never overwritten.
+func ImportEncryptedKeyFile(path string, privateKey crypto.Signer, passphrase []byte) error {
+	data, err := EncryptPrivateKey(privateKey, passphrase)
+	if err != nil {
+		return err
+	}
+
+	return writeKeyFile(path, data)
+}
+
+// ReencryptKeyFile replaces the passphrase of an encrypted key file. The file is replaced atomically.
+func ReencryptKeyFile(path string, passphrase, newPassphrase []byte) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := DecryptPrivateKey(data, passphrase)
+	if err != nil {
+		return err
+	}
+
+	data, err = EncryptPrivateKey(privateKey, newPassphrase)
+	if err != nil {
+		return err
+	}
+
+	// A unique temporary file is created with mode 0600, a leftover of an interrupted change does not block this one.
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := file.Name()
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+
+	return nil
+}
+
+// EncryptedFileKeyStore reads a key sealed by EncryptPrivateKey.
+type EncryptedFileKeyStore struct {
+	Path       string
+	Passphrase []byte
+}
+
+func (s *EncryptedFileKeyStore) Load() (*Key, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file %s: %w", s.Path, err)
+	}
+
+	privateKey, err := DecryptPrivateKey(data, s.Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt key file %s: %w", s.Path, err)
+	}
+
+	info, err := os.Stat(s.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewActiveKey(privateKey, info.ModTime())
+}
+
+func (s *EncryptedFileKeyStore) Close() error {
+	return nil
+}
@@ -0,0 +1,331 @@
+//go:build pkcs11
+
+package internal
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/miekg/pkcs11"
+)
+
+// PKCS#11 3.0 identifiers missing from github.com/miekg/pkcs11.
+const (
+	_ckkECEdwards = 0x00000040
+	_ckmEdDSA     = 0x00001057
+)
+
+// DigestInfo prefixes of PKCS #1 v1.5 signatures, CKM_RSA_PKCS pads the DigestInfo only.
+var _pkcs1DigestInfoPrefixes = map[crypto.Hash][]byte{
+	crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+	crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+	crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+}
+
+// PKCS11KeyStore uses an Ed25519, ECDSA or RSA private key which never leaves a PKCS#11 token.
+type PKCS11KeyStore struct {
+	config PKCS11Config
+
+	// A session handles one operation at a time.
+	mu      sync.Mutex
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+}
+
+// NewPKCS11KeyStore opens a logged in session on the token labelled config.TokenLabel.
+func NewPKCS11KeyStore(config PKCS11Config) (KeyStore, error) {
+	ctx := pkcs11.New(config.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("load PKCS#11 module %s", config.Module)
+	}
+
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("initialize PKCS#11 module: %w", err)
+	}
+
+	store := &PKCS11KeyStore{config: config, ctx: ctx}
+	if err := store.open(); err != nil {
+		_ = ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+
+	return store, nil
+}
+
+func (s *PKCS11KeyStore) open() error {
+	slots, err := s.ctx.GetSlotList(true)
+	if err != nil {
+		return fmt.Errorf("list PKCS#11 slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		info, err := s.ctx.GetTokenInfo(slot)
+		if err != nil || info.Label != s.config.TokenLabel {
+			continue
+		}
+
+		session, err := s.ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+		if err != nil {
+			return fmt.Errorf("open PKCS#11 session: %w", err)
+		}
+
+		if err := s.ctx.Login(session, pkcs11.CKU_USER, s.config.PIN); err != nil {
+			_ = s.ctx.CloseSession(session)
+			return fmt.Errorf("login to PKCS#11 token %s: %w", s.config.TokenLabel, err)
+		}
+
+		s.session = session
+		return nil
+	}
+
+	return fmt.Errorf("PKCS#11 token %s not found", s.config.TokenLabel)
+}
+
+func (s *PKCS11KeyStore) Load() (*Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	privateKey, err := s.findObject(pkcs11.CKO_PRIVATE_KEY)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyObject, err := s.findObject(pkcs11.CKO_PUBLIC_KEY)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := s.readPublicKey(publicKeyObject)
+	if err != nil {
+		return nil, fmt.Errorf("read PKCS#11 public key %s: %w", s.config.KeyLabel, err)
+	}
+
+	// Tokens do not keep a creation time, the time of loading is used instead.
+	return NewActiveKey(&pkcs11Signer{store: s, handle: privateKey, publicKey: publicKey}, time.Now())
+}
+
+func (s *PKCS11KeyStore) findObject(class uint) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, s.config.KeyLabel),
+	}
+
+	if err := s.ctx.FindObjectsInit(s.session, template); err != nil {
+		return 0, fmt.Errorf("find PKCS#11 object %s: %w", s.config.KeyLabel, err)
+	}
+	defer func() { _ = s.ctx.FindObjectsFinal(s.session) }()
+
+	objects, _, err := s.ctx.FindObjects(s.session, 1)
+	if err != nil {
+		return 0, fmt.Errorf("find PKCS#11 object %s: %w", s.config.KeyLabel, err)
+	}
+
+	if len(objects) == 0 {
+		return 0, fmt.Errorf("PKCS#11 object %s of class %d not found", s.config.KeyLabel, class)
+	}
+
+	return objects[0], nil
+}
+
+func (s *PKCS11KeyStore) readPublicKey(object pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attributes, err := s.ctx.GetAttributeValue(s.session, object, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// CK_ULONG in the byte order of the host, little endian on every supported platform.
+	var keyType uint
+	for i, b := range attributes[0].Value {
+		keyType |= uint(b) << (8 * i)
+	}
+
+	switch keyType {
+	case _ckkECEdwards:
+		attributes, err := s.ctx.GetAttributeValue(s.session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return parseEdwardsPoint(attributes[0].Value)
+	case pkcs11.CKK_EC:
+		attributes, err := s.ctx.GetAttributeValue(s.session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return parseECPoint(attributes[0].Value, attributes[1].Value)
+	case pkcs11.CKK_RSA:
+		attributes, err := s.ctx.GetAttributeValue(s.session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		exponent := new(big.Int).SetBytes(attributes[1].Value)
+		if !exponent.IsInt64() || exponent.Int64() > math.MaxInt32 {
+			return nil, fmt.Errorf("%w: RSA exponent", ErrUnsupportedKey)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(attributes[0].Value), E: int(exponent.Int64())}, nil
+	default:
+		return nil, fmt.Errorf("%w: PKCS#11 key type %#x", ErrUnsupportedKey, keyType)
+	}
+}
+
+// parseECPoint decodes a DER encoded named curve and a DER wrapped uncompressed point.
+func parseECPoint(params, point []byte) (*ecdsa.PublicKey, error) {
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &oid); err != nil {
+		return nil, fmt.Errorf("%w: malformed PKCS#11 EC parameters", ErrUnsupportedKey)
+	}
+
+	var curve elliptic.Curve
+	switch {
+	case oid.Equal(_oidCurveP256):
+		curve = elliptic.P256()
+	case oid.Equal(_oidCurveP384):
+		curve = elliptic.P384()
+	default:
+		return nil, fmt.Errorf("%w: curve %s", ErrUnsupportedKey, oid)
+	}
+
+	var raw []byte
+	if rest, err := asn1.Unmarshal(point, &raw); err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: malformed PKCS#11 EC point", ErrUnsupportedKey)
+	}
+
+	x, y := elliptic.Unmarshal(curve, raw)
+	if x == nil {
+		return nil, fmt.Errorf("%w: malformed PKCS#11 EC point", ErrUnsupportedKey)
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// parseEdwardsPoint accepts both DER wrapped (as written by SoftHSM) and raw CKA_EC_POINT values.
+func parseEdwardsPoint(point []byte) (ed25519.PublicKey, error) {
+	if len(point) == ed25519.PublicKeySize {
+		return point, nil
+	}
+
+	var raw []byte
+	if rest, err := asn1.Unmarshal(point, &raw); err != nil || len(rest) != 0 || len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: malformed PKCS#11 Edwards point", ErrUnsupportedKey)
+	}
+
+	return raw, nil
+}
+
+func (s *PKCS11KeyStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_ = s.ctx.Logout(s.session)
+	_ = s.ctx.CloseSession(s.session)
+	err := s.ctx.Finalize()
+	s.ctx.Destroy()
+	return err
+}
+
+type pkcs11Signer struct {
+	store     *PKCS11KeyStore
+	handle    pkcs11.ObjectHandle
+	publicKey crypto.PublicKey
+}
+
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign follows crypto.Signer: Ed25519 keys sign the message, other keys sign a digest made with opts.HashFunc().
+// ECDSA signatures are returned ASN.1 DER encoded, RSA keys sign with PSS or, for PKCS #1 v1.5 options, with
+// CKM_RSA_PKCS.
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var mechanism *pkcs11.Mechanism
+	switch s.publicKey.(type) {
+	case ed25519.PublicKey:
+		if opts.HashFunc() != 0 {
+			// Ed25519ph needs CK_EDDSA_PARAMS which github.com/miekg/pkcs11 cannot pass.
+			return nil, fmt.Errorf("%w: PKCS#11 Ed25519 keys do not sign prehashed digests", ErrUnsupportedDigest)
+		}
+		mechanism = pkcs11.NewMechanism(_ckmEdDSA, nil)
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	case *rsa.PublicKey:
+		if pssOptions, ok := opts.(*rsa.PSSOptions); ok {
+			params, err := pssParams(pssOptions)
+			if err != nil {
+				return nil, err
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, params)
+			break
+		}
+
+		// PKCS #1 v1.5, used by OpenPGP.
+		prefix, ok := _pkcs1DigestInfoPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, fmt.Errorf("%w: PKCS #1 v1.5 hash %s", ErrUnsupportedKey, opts.HashFunc())
+		}
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+		digest = append(append([]byte(nil), prefix...), digest...)
+	}
+
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+
+	if err := s.store.ctx.SignInit(s.store.session, []*pkcs11.Mechanism{mechanism}, s.handle); err != nil {
+		return nil, fmt.Errorf("PKCS#11 sign: %w", err)
+	}
+
+	signature, err := s.store.ctx.Sign(s.store.session, digest)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 sign: %w", err)
+	}
+
+	if _, ok := s.publicKey.(*ecdsa.PublicKey); ok {
+		// Tokens return r || s, Go and the service use the ASN.1 form.
+		half := len(signature) / 2
+		return asn1.Marshal(struct{ R, S *big.Int }{
+			R: new(big.Int).SetBytes(signature[:half]),
+			S: new(big.Int).SetBytes(signature[half:]),
+		})
+	}
+
+	return signature, nil
+}
+
+func pssParams(opts *rsa.PSSOptions) ([]byte, error) {
+	hash := opts.HashFunc()
+	if opts.SaltLength != rsa.PSSSaltLengthEqualsHash && opts.SaltLength != hash.Size() {
+		return nil, fmt.Errorf("%w: PSS salt length %d", ErrUnsupportedKey, opts.SaltLength)
+	}
+
+	switch hash {
+	case crypto.SHA256:
+		return pkcs11.NewPSSParams(pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256, uint(hash.Size())), nil
+	case crypto.SHA384:
+		return pkcs11.NewPSSParams(pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384, uint(hash.Size())), nil
+	case crypto.SHA512:
+		return pkcs11.NewPSSParams(pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512, uint(hash.Size())), nil
+	default:
+		return nil, fmt.Errorf("%w: PSS hash %s", ErrUnsupportedKey, hash)
+	}
+}
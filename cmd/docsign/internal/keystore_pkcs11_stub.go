@@ -0,0 +1,12 @@
+//go:build !pkcs11
+
+package internal
+
+import "errors"
+
+var ErrPKCS11Unsupported = errors.New("built without PKCS#11 support, rebuild with -tags pkcs11")
+
+// NewPKCS11KeyStore is available with the pkcs11 build tag only, it requires cgo.
+func NewPKCS11KeyStore(PKCS11Config) (KeyStore, error) {
+	return nil, ErrPKCS11Unsupported
+}
@@ -0,0 +1,43 @@
+//go:build pkcs11
+
+package internal
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPKCS11KeyStore runs against a token prepared beforehand, e.g. with SoftHSM:
+//
+//	softhsm2-util --init-token --free --label docsign --pin 1234 --so-pin 1234
+//	pkcs11-tool --module /usr/lib/softhsm/libsofthsm2.so --token-label docsign --login --pin 1234 \
+//		--keypairgen --key-type EC:edwards25519 --label docsign
+//	DOCSIGN_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so DOCSIGN_PKCS11_PIN=1234 go test -tags pkcs11 ./...
+func TestPKCS11KeyStore(t *testing.T) {
+	module := os.Getenv("DOCSIGN_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("DOCSIGN_PKCS11_MODULE is not set")
+	}
+
+	store, err := NewKeyStore(KeyStoreConfig{
+		Backend: KeyStorePKCS11,
+		PKCS11: PKCS11Config{
+			Module:     module,
+			TokenLabel: "docsign",
+			PIN:        os.Getenv("DOCSIGN_PKCS11_PIN"),
+			KeyLabel:   "docsign",
+		},
+	})
+	assert.NoError(t, err)
+	defer store.Close()
+
+	key, err := store.Load()
+	assert.NoError(t, err)
+
+	data := randData(t, 1024)
+	sign, err := key.Sign(data)
+	assert.NoError(t, err)
+	assert.True(t, key.Verify(data, sign))
+}
@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"crypto/ed25519"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fastEncryptedKeyHeader(t *testing.T) *EncryptedKeyHeader {
+	header, err := DefaultEncryptedKeyHeader()
+	assert.NoError(t, err)
+	header.Time, header.Memory, header.Threads = 1, 64, 1
+	return header
+}
+
+func TestNewKeyStore(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "plain.key")
+	encryptedPath := filepath.Join(dir, "encrypted.key")
+	passphrase := []byte("correct horse battery staple")
+
+	publicKey, err := GenerateKeyFile(plainPath, AlgorithmEd25519)
+	assert.NoError(t, err)
+
+	privateKey, _, err := LoadPrivateKey(plainPath)
+	assert.NoError(t, err)
+
+	encrypted, err := encryptPrivateKey(privateKey, passphrase, fastEncryptedKeyHeader(t))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(encryptedPath, encrypted, _privateKeyFileMode))
+
+	tests := []struct {
+		name       string
+		shouldFail bool
+		config     KeyStoreConfig
+	}{
+		{
+			name:   "Case #1",
+			config: KeyStoreConfig{Path: plainPath},
+		},
+		{
+			name:   "Case #2",
+			config: KeyStoreConfig{Backend: KeyStoreFile, Path: plainPath},
+		},
+		{
+			name:   "Case #3",
+			config: KeyStoreConfig{Backend: KeyStoreEncrypted, Path: encryptedPath, Passphrase: passphrase},
+		},
+		{
+			name:       "Case #4",
+			shouldFail: true,
+			config:     KeyStoreConfig{Backend: KeyStoreEncrypted, Path: encryptedPath, Passphrase: []byte("wrong")},
+		},
+		{
+			name:       "Case #5",
+			shouldFail: true,
+			config:     KeyStoreConfig{Backend: KeyStoreEncrypted, Path: plainPath, Passphrase: passphrase},
+		},
+		{
+			name:       "Case #6",
+			shouldFail: true,
+			config:     KeyStoreConfig{Backend: KeyStoreFile, Path: encryptedPath},
+		},
+		{
+			name:       "Case #7",
+			shouldFail: true,
+			config:     KeyStoreConfig{Backend: "vault"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := NewKeyStore(tt.config)
+			if err == nil {
+				defer store.Close()
+				var key *Key
+				key, err = store.Load()
+				if err == nil {
+					assert.Equal(t, publicKey, key.PublicKey)
+				}
+			}
+
+			if tt.shouldFail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecryptPrivateKey_Tampered(t *testing.T) {
+	t.Parallel()
+
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	header := fastEncryptedKeyHeader(t)
+	encrypted, err := encryptPrivateKey(privateKey, []byte("passphrase"), header)
+	assert.NoError(t, err)
+
+	parsed, err := ParseEncryptedKeyHeader(encrypted)
+	assert.NoError(t, err)
+	assert.Equal(t, header, parsed)
+
+	decrypted, err := DecryptPrivateKey(encrypted, []byte("passphrase"))
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey, decrypted)
+
+	// Header fields are authenticated as additional data.
+	header.Time++
+	_, prefix, sealed, err := decodeEncryptedKey(encrypted)
+	assert.NoError(t, err)
+	assert.Len(t, prefix, len(header.marshal()))
+
+	tampered := append(header.marshal(), sealed...)
+	_, err = DecryptPrivateKey(pem.EncodeToMemory(&pem.Block{Type: _encryptedKeyPemType, Bytes: tampered}), []byte("passphrase"))
+	assert.ErrorIs(t, err, ErrBadPassphrase)
+
+	// Parameters beyond the bounds are rejected before a key is derived.
+	header.Time = _maxKDFTime + 1
+	tampered = append(header.marshal(), sealed...)
+	_, err = DecryptPrivateKey(pem.EncodeToMemory(&pem.Block{Type: _encryptedKeyPemType, Bytes: tampered}), []byte("passphrase"))
+	assert.ErrorIs(t, err, ErrBadEncryptedKey)
+}
+
+func TestReencryptKeyFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "encrypted.key")
+
+	publicKey, err := CreateEncryptedKeyFile(path, AlgorithmEd25519, []byte("old"))
+	assert.NoError(t, err)
+
+	_, err = CreateEncryptedKeyFile(path, AlgorithmEd25519, []byte("old"))
+	assert.Error(t, err)
+
+	// A leftover of an interrupted change is neither reused nor in the way.
+	assert.NoError(t, os.WriteFile(path+".tmp", []byte("stale"), _privateKeyFileMode))
+
+	assert.ErrorIs(t, ReencryptKeyFile(path, []byte("wrong"), []byte("new")), ErrBadPassphrase)
+	assert.NoError(t, ReencryptKeyFile(path, []byte("old"), []byte("new")))
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(_privateKeyFileMode), info.Mode().Perm())
+
+	key, err := (&EncryptedFileKeyStore{Path: path, Passphrase: []byte("new")}).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, publicKey, key.PublicKey)
+
+	_, err = (&EncryptedFileKeyStore{Path: path, Passphrase: []byte("old")}).Load()
+	assert.ErrorIs(t, err, ErrBadPassphrase)
+}
+
+func TestReadPassphrase(t *testing.T) {
+	const env = "DOCSIGN_TEST_PASSPHRASE"
+
+	t.Setenv(env, "from env")
+	passphrase, err := ReadPassphrase(PassphraseSource{Env: env, FD: -1})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("from env"), passphrase)
+
+	_, ok := os.LookupEnv(env)
+	assert.False(t, ok)
+
+	reader, writer, err := os.Pipe()
+	assert.NoError(t, err)
+	_, err = writer.WriteString("from fd\r\nignored\n")
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	passphrase, err = ReadPassphrase(PassphraseSource{Env: env, FD: int(reader.Fd())})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("from fd"), passphrase)
+
+	_, err = ReadPassphrase(PassphraseSource{FD: -1})
+	assert.ErrorIs(t, err, ErrNoPassphrase)
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+const (
+	LargeDocumentSignPath   = "/v1/large-document/sign"
+	LargeDocumentVerifyPath = "/v1/large-document/verify"
+
+	// Well below the default 4 MB limit of a gRPC message.
+	_largeDocumentChunkSize = 1024 * 1024
+)
+
+// SignLargeDocumentHandler streams a raw HTTP request body into SignLargeDocument, so documents of any size can be
+// uploaded with chunked transfer encoding. Fields of LargeDocumentHeader are read from query parameters,
+// e.g. ?hash=HASH_ALGORITHM_SHA512.
+func SignLargeDocumentHandler(mux *runtime.ServeMux, client pb.SignServiceClient) runtime.HandlerFunc {
+	return largeDocumentHandler(mux, "/signservice.SignService/SignLargeDocument",
+		func(ctx context.Context, header *pb.LargeDocumentHeader, body io.Reader) (proto.Message, runtime.ServerMetadata, error) {
+			var metadata runtime.ServerMetadata
+			stream, err := client.SignLargeDocument(ctx)
+			if err != nil {
+				return nil, metadata, err
+			}
+
+			if err := sendLargeDocument(stream, header, body); err != nil {
+				return nil, metadata, err
+			}
+
+			sign, err := stream.CloseAndRecv()
+			return sign, largeDocumentMetadata(stream), err
+		})
+}
+
+// VerifyLargeDocumentHandler is SignLargeDocumentHandler for VerifyLargeDocument. The signature is passed as
+// query parameters, e.g. ?sign.sign=<base64>&sign.key_id=<id>.
+func VerifyLargeDocumentHandler(mux *runtime.ServeMux, client pb.SignServiceClient) runtime.HandlerFunc {
+	return largeDocumentHandler(mux, "/signservice.SignService/VerifyLargeDocument",
+		func(ctx context.Context, header *pb.LargeDocumentHeader, body io.Reader) (proto.Message, runtime.ServerMetadata, error) {
+			var metadata runtime.ServerMetadata
+			stream, err := client.VerifyLargeDocument(ctx)
+			if err != nil {
+				return nil, metadata, err
+			}
+
+			if err := sendLargeDocument(stream, header, body); err != nil {
+				return nil, metadata, err
+			}
+
+			response, err := stream.CloseAndRecv()
+			return response, largeDocumentMetadata(stream), err
+		})
+}
+
+type largeDocumentCall func(ctx context.Context, header *pb.LargeDocumentHeader, body io.Reader) (proto.Message, runtime.ServerMetadata, error)
+
+// largeDocumentHandler does what generated gateway handlers do around call: metadata forwarding, query parsing
+// and error and response marshalling.
+func largeDocumentHandler(mux *runtime.ServeMux, method string, call largeDocumentCall) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		header := &pb.LargeDocumentHeader{}
+		if err := runtime.PopulateQueryParameters(header, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "%v", err))
+			return
+		}
+
+		response, metadata, err := call(ctx, header, r.Body)
+		ctx = runtime.NewServerMetadataContext(ctx, metadata)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, response)
+	}
+}
+
+func largeDocumentMetadata(stream grpc.ClientStream) runtime.ServerMetadata {
+	header, _ := stream.Header()
+	return runtime.ServerMetadata{HeaderMD: header, TrailerMD: stream.Trailer()}
+}
+
+type largeDocumentSender interface {
+	Send(*pb.LargeDocumentChunk) error
+}
+
+// sendLargeDocument sends header followed by body in chunks of _largeDocumentChunkSize bytes.
+func sendLargeDocument(stream largeDocumentSender, header *pb.LargeDocumentHeader, body io.Reader) error {
+	if err := stream.Send(&pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Header{Header: header}}); err != nil {
+		return ignoreEOF(err)
+	}
+
+	buffer := make([]byte, _largeDocumentChunkSize)
+	for {
+		n, err := io.ReadFull(body, buffer)
+		if n > 0 {
+			if err := stream.Send(&pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Data{Data: buffer[:n]}}); err != nil {
+				return ignoreEOF(err)
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		} else if err != nil {
+			return status.Errorf(codes.InvalidArgument, "read document: %v", err)
+		}
+	}
+}
+
+// ignoreEOF hides the io.EOF Send returns once the server has answered, CloseAndRecv returns the answer instead.
+func ignoreEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
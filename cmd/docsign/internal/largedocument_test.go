@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLargeDocumentHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := newTestKey(t)
+	client, closer := serveKeyring(t, ctx, NewKeyring(key))
+	defer closer()
+
+	mux := runtime.NewServeMux()
+	assert.NoError(t, mux.HandlePath(http.MethodPost, LargeDocumentSignPath, SignLargeDocumentHandler(mux, client)))
+	assert.NoError(t, mux.HandlePath(http.MethodPost, LargeDocumentVerifyPath, VerifyLargeDocumentHandler(mux, client)))
+
+	post := func(target string, document []byte) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(document))
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	document := randData(t, 3*_largeDocumentChunkSize+17)
+	response := post(LargeDocumentSignPath, document)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	var sign struct {
+		Sign  []byte `json:"sign"`
+		KeyID string `json:"keyId"`
+	}
+	assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &sign))
+	assert.Equal(t, key.ID, sign.KeyID)
+
+	digest := sha512.Sum512(document)
+	assert.NoError(t, ed25519.VerifyWithOptions(key.PublicKey.(ed25519.PublicKey), digest[:], sign.Sign, &ed25519.Options{Hash: crypto.SHA512}))
+
+	verify := func(document []byte, hash string) bool {
+		query := url.Values{"sign.sign": {base64.StdEncoding.EncodeToString(sign.Sign)}, "sign.key_id": {sign.KeyID}}
+		if hash != "" {
+			query.Set("hash", hash)
+		}
+
+		response := post(LargeDocumentVerifyPath+"?"+query.Encode(), document)
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		var verification struct {
+			IsOk bool `json:"isOk"`
+		}
+		assert.NoError(t, json.Unmarshal(response.Body.Bytes(), &verification))
+		return verification.IsOk
+	}
+
+	assert.True(t, verify(document, ""))
+	assert.True(t, verify(document, "HASH_ALGORITHM_SHA512"))
+	assert.False(t, verify(document[1:], ""))
+
+	response = post(LargeDocumentSignPath+"?hash=HASH_ALGORITHM_SHA256", document)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = post(LargeDocumentSignPath+"?hash=SHA3", document)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// Merkle tree batches follow the tree hashing of RFC 9162 with SHA-256: leaves are the SHA-256 digests of the
+// documents, leaf hashes are SHA-256(0x00 || leaf) and node hashes SHA-256(0x01 || left || right).
+
+// _merkleTreeHeadContext starts the encoding of a signed tree head.
+const _merkleTreeHeadContext = "docsign-merkle-tree-head-v1\x00"
+
+var (
+	ErrEmptyBatch              = errors.New("empty batch")
+	ErrMalformedInclusionProof = errors.New("malformed inclusion proof")
+
+	errInclusionMismatch = fmt.Errorf("inclusion proof %w", ErrSignatureMismatch)
+)
+
+// MerkleTreeHead is the signed root of a Merkle tree batch.
+type MerkleTreeHead struct {
+	TreeSize  uint64
+	RootHash  []byte
+	KeyID     string
+	Algorithm Algorithm
+	Signature []byte
+}
+
+// Encode returns the signed encoding of the tree head: the context string, the 64-bit big-endian tree size and the
+// root hash.
+func (h *MerkleTreeHead) Encode() []byte {
+	encoding := []byte(_merkleTreeHeadContext)
+	encoding = binary.BigEndian.AppendUint64(encoding, h.TreeSize)
+	return append(encoding, h.RootHash...)
+}
+
+// InclusionProof proves a document is the leaf LeafIndex of the tree of a signed tree head.
+type InclusionProof struct {
+	LeafIndex uint64
+	// AuditPath is the RFC 9162 inclusion path from the leaf to the root.
+	AuditPath [][]byte
+	TreeHead  *MerkleTreeHead
+}
+
+// MerkleLeafHash returns the leaf hash of a document.
+func MerkleLeafHash(document []byte) []byte {
+	leaf := sha256.Sum256(document)
+	hash := sha256.Sum256(append([]byte{0}, leaf[:]...))
+	return hash[:]
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte{1})
+	hasher.Write(left)
+	hasher.Write(right)
+	return hasher.Sum(nil)
+}
+
+// merkleTree returns the root hash of the tree of leaf hashes and appends the inclusion path of every leaf to paths.
+func merkleTree(hashes [][]byte, paths [][][]byte) []byte {
+	if len(hashes) == 1 {
+		return hashes[0]
+	}
+
+	// The left subtree holds the largest power of two leaves less than the tree size.
+	split := 1 << (bits.Len(uint(len(hashes)-1)) - 1)
+	left := merkleTree(hashes[:split], paths[:split])
+	right := merkleTree(hashes[split:], paths[split:])
+
+	for i := range paths[:split] {
+		paths[i] = append(paths[i], right)
+	}
+	for i := range paths[split:] {
+		paths[split+i] = append(paths[split+i], left)
+	}
+	return merkleNodeHash(left, right)
+}
+
+// SignMerkleBatch signs the root of the Merkle tree of documents once and returns the inclusion proof of every
+// document in order.
+func SignMerkleBatch(key *Key, documents [][]byte) ([]*InclusionProof, error) {
+	if len(documents) == 0 {
+		return nil, ErrEmptyBatch
+	}
+
+	hashes := make([][]byte, len(documents))
+	for i, document := range documents {
+		hashes[i] = MerkleLeafHash(document)
+	}
+
+	paths := make([][][]byte, len(documents))
+	head := &MerkleTreeHead{TreeSize: uint64(len(documents)), KeyID: key.ID, Algorithm: key.Algorithm}
+	head.RootHash = merkleTree(hashes, paths)
+
+	signature, err := key.Sign(head.Encode())
+	if err != nil {
+		return nil, err
+	}
+	head.Signature = signature
+
+	proofs := make([]*InclusionProof, len(documents))
+	for i := range documents {
+		proofs[i] = &InclusionProof{LeafIndex: uint64(i), AuditPath: paths[i], TreeHead: head}
+	}
+	return proofs, nil
+}
+
+// VerifyInclusion checks the signature of the tree head of proof with a key of keyring and that document is the
+// leaf the proof is for.
+func VerifyInclusion(keyring *Keyring, document []byte, proof *InclusionProof) (*Key, error) {
+	head := proof.TreeHead
+	if head == nil || len(head.RootHash) != sha256.Size || proof.LeafIndex >= head.TreeSize {
+		return nil, fmt.Errorf("%w: no tree head or leaf index out of range", ErrMalformedInclusionProof)
+	}
+
+	if head.KeyID == "" {
+		return nil, fmt.Errorf("%w: no key id", ErrMalformedInclusionProof)
+	}
+
+	key, err := keyring.verifyingKey(head.KeyID, head.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSignatureFormat(key, head.Signature); err != nil {
+		return nil, err
+	}
+
+	if !key.Verify(head.Encode(), head.Signature) {
+		return nil, fmt.Errorf("%w: tree head signature", errInclusionMismatch)
+	}
+
+	root, err := merkleRootFromPath(proof.LeafIndex, head.TreeSize, MerkleLeafHash(document), proof.AuditPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(root, head.RootHash) {
+		return nil, errInclusionMismatch
+	}
+	return key, nil
+}
+
+// merkleRootFromPath computes the root hash from an inclusion path (RFC 9162 2.1.3.2).
+func merkleRootFromPath(index, size uint64, hash []byte, path [][]byte) ([]byte, error) {
+	fn, sn := index, size-1
+	for _, sibling := range path {
+		if sn == 0 || len(sibling) != sha256.Size {
+			return nil, fmt.Errorf("%w: audit path", ErrMalformedInclusionProof)
+		}
+
+		if fn&1 == 1 || fn == sn {
+			hash = merkleNodeHash(sibling, hash)
+			for fn&1 == 0 && fn != 0 {
+				fn, sn = fn>>1, sn>>1
+			}
+		} else {
+			hash = merkleNodeHash(hash, sibling)
+		}
+		fn, sn = fn>>1, sn>>1
+	}
+
+	if sn != 0 {
+		return nil, fmt.Errorf("%w: audit path", ErrMalformedInclusionProof)
+	}
+	return hash, nil
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerkleTree(t *testing.T) {
+	t.Parallel()
+
+	// The leaves and roots of the RFC 6962 test vectors of the certificate-transparency project.
+	leaves := []string{"", "00", "10", "2021", "3031", "40414243", "5051525354555657", "606162636465666768696a6b6c6d6e6f"}
+	roots := []string{
+		"6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d",
+		"fac54203e7cc696cf0dfcb42c92a1d9dbaf70ad9e621f4bd8d98662f00e3c125",
+		"aeb6bcfe274b70a14fb067a5e5578264db0fa9b51af5e0ba159158f329e06e77",
+		"d37ee418976dd95753c1c73862b9398fa2a2cf9b4ff0fdfe8b30cd95209614b7",
+		"4e3bbb1f7b478dcfe71fb631631519a3bca12c9aefca1612bfce4c13a86264d4",
+		"76e67dadbcdf1e10e1b74ddc608abd2f98dfb16fbce75277b5232a127f2087ef",
+		"ddb89be403809e325750d3d263cd78929c2942b7942a34b77e122c9594a74c8c",
+		"5dc9da79a70659a9ad559cb701ded9a2ab9d823aad2f4960cfe370eff4604328",
+	}
+
+	hashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		data, err := hex.DecodeString(leaf)
+		assert.NoError(t, err)
+		hash := sha256.Sum256(append([]byte{0}, data...))
+		hashes[i] = hash[:]
+	}
+
+	for size := 1; size <= len(leaves); size++ {
+		paths := make([][][]byte, size)
+		root := merkleTree(hashes[:size], paths)
+		assert.Equal(t, roots[size-1], hex.EncodeToString(root), size)
+
+		for i := range paths {
+			computed, err := merkleRootFromPath(uint64(i), uint64(size), hashes[i], paths[i])
+			assert.NoError(t, err)
+			assert.Equal(t, root, computed)
+		}
+	}
+}
+
+func TestSignMerkleBatch(t *testing.T) {
+	t.Parallel()
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmRSAPSS2048SHA256, AlgorithmMLDSA44} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			key := newTestAlgorithmKey(t, algorithm)
+			keyring := NewKeyring(newTestKey(t), key.retire())
+
+			for _, size := range []int{1, 2, 3, 7, 16, 33} {
+				documents := make([][]byte, size)
+				for i := range documents {
+					documents[i] = randData(t, 10+i)
+				}
+
+				proofs, err := SignMerkleBatch(key, documents)
+				assert.NoError(t, err)
+				assert.Len(t, proofs, size)
+
+				for i, proof := range proofs {
+					assert.Equal(t, uint64(i), proof.LeafIndex)
+					assert.Equal(t, uint64(size), proof.TreeHead.TreeSize)
+					assert.Same(t, proofs[0].TreeHead, proof.TreeHead)
+
+					verifyingKey, err := VerifyInclusion(keyring, documents[i], proof)
+					assert.NoError(t, err)
+					assert.Equal(t, key.ID, verifyingKey.ID)
+
+					_, err = VerifyInclusion(keyring, documents[(i+1)%size][1:], proof)
+					assert.ErrorIs(t, err, errInclusionMismatch)
+				}
+			}
+		})
+	}
+
+	_, err := SignMerkleBatch(newTestKey(t), nil)
+	assert.ErrorIs(t, err, ErrEmptyBatch)
+}
+
+func TestVerifyInclusion(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	keyring := NewKeyring(key)
+	documents := [][]byte{randData(t, 10), randData(t, 20), randData(t, 30), randData(t, 40), randData(t, 50)}
+
+	proofs, err := SignMerkleBatch(key, documents)
+	assert.NoError(t, err)
+	proof := proofs[2]
+
+	modified := func(modify func(proof *InclusionProof)) *InclusionProof {
+		head := *proof.TreeHead
+		copied := &InclusionProof{LeafIndex: proof.LeafIndex, AuditPath: append([][]byte(nil), proof.AuditPath...), TreeHead: &head}
+		modify(copied)
+		return copied
+	}
+
+	tests := []struct {
+		name  string
+		proof *InclusionProof
+		err   error
+	}{
+		{name: "Case #1", proof: proof, err: nil},
+		{name: "Case #2", proof: modified(func(p *InclusionProof) { p.LeafIndex = 3 }), err: errInclusionMismatch},
+		{name: "Case #3", proof: modified(func(p *InclusionProof) { p.LeafIndex = 5 }), err: ErrMalformedInclusionProof},
+		{name: "Case #4", proof: modified(func(p *InclusionProof) { p.AuditPath = p.AuditPath[1:] }), err: ErrMalformedInclusionProof},
+		{name: "Case #5", proof: modified(func(p *InclusionProof) { p.AuditPath = append(p.AuditPath, p.AuditPath[0]) }), err: ErrMalformedInclusionProof},
+		{name: "Case #6", proof: modified(func(p *InclusionProof) { p.AuditPath[0] = p.AuditPath[0][1:] }), err: ErrMalformedInclusionProof},
+		{name: "Case #7", proof: modified(func(p *InclusionProof) { p.AuditPath[0], p.AuditPath[1] = p.AuditPath[1], p.AuditPath[0] }), err: errInclusionMismatch},
+		{name: "Case #8", proof: modified(func(p *InclusionProof) { p.TreeHead.TreeSize = 6 }), err: errInclusionMismatch},
+		{name: "Case #9", proof: modified(func(p *InclusionProof) { p.TreeHead.RootHash = MerkleLeafHash(documents[2]) }), err: errInclusionMismatch},
+		{name: "Case #10", proof: modified(func(p *InclusionProof) { p.TreeHead.KeyID = newTestKey(t).ID }), err: ErrUnknownKey},
+		{name: "Case #11", proof: modified(func(p *InclusionProof) { p.TreeHead.Algorithm = AlgorithmMLDSA44 }), err: ErrUnknownKey},
+		{name: "Case #12", proof: modified(func(p *InclusionProof) { p.TreeHead = nil }), err: ErrMalformedInclusionProof},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyInclusion(keyring, documents[2], tt.proof)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// minisign signature algorithms: Ed25519 over the message or, since minisign 0.8, over its BLAKE2b-512 digest.
+const (
+	_minisignAlgorithmLegacy    = "Ed"
+	_minisignAlgorithmPrehashed = "ED"
+
+	_minisignUntrustedPrefix = "untrusted comment: "
+	_minisignTrustedPrefix   = "trusted comment: "
+
+	// Algorithm, key number and signature.
+	_minisignSignatureSize = 2 + 8 + ed25519.SignatureSize
+	// Algorithm, key number and public key.
+	_minisignPublicKeySize = 2 + 8 + ed25519.PublicKeySize
+)
+
+var (
+	ErrNoMinisignAlgorithm = errors.New("no minisign algorithm")
+	ErrMalformedMinisign   = errors.New("malformed minisign signature")
+	ErrMinisignComment     = errors.New("minisign comments are single line")
+)
+
+// MinisignOptions tells how SignMinisign makes a signature.
+type MinisignOptions struct {
+	// Legacy signs the message itself instead of its BLAKE2b-512 digest. minisign 0.8 and later verify both.
+	Legacy bool
+	// TrustedComment is signed by the global signature, "timestamp:<unix time>" when empty.
+	TrustedComment string
+	// UntrustedComment is not signed, "signature from docsign key <key number>" when empty.
+	UntrustedComment string
+}
+
+// MinisignVerification is a verified minisign signature.
+type MinisignVerification struct {
+	Key            *Key
+	TrustedComment string
+}
+
+// MinisignKeyNumber returns the minisign key number of key in the hex form minisign prints. The number is the
+// key id, so it is stable and identifies the key in the keyring.
+func MinisignKeyNumber(key *Key) (string, error) {
+	keyNumber, err := minisignKeyNumber(key)
+	if err != nil {
+		return "", err
+	}
+	// minisign prints the little endian key number as an integer.
+	return fmt.Sprintf("%016X", binary.LittleEndian.Uint64(keyNumber)), nil
+}
+
+// SignMinisign makes a minisign signature of data with an Ed25519 key: the untrusted comment, the signature, the
+// trusted comment and the global signature of the signature and the trusted comment, as `minisign -S` writes them.
+func SignMinisign(key *Key, data []byte, options MinisignOptions) ([]byte, error) {
+	keyNumber, err := minisignKeyNumber(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Signer == nil {
+		return nil, fmt.Errorf("key %s is %s", key.ID, key.Status)
+	}
+
+	trustedComment := options.TrustedComment
+	if trustedComment == "" {
+		trustedComment = fmt.Sprintf("timestamp:%d", time.Now().Unix())
+	}
+
+	untrustedComment := options.UntrustedComment
+	if untrustedComment == "" {
+		number, _ := MinisignKeyNumber(key)
+		untrustedComment = "signature from docsign key " + number
+	}
+
+	if strings.ContainsAny(trustedComment+untrustedComment, "\r\n") {
+		return nil, ErrMinisignComment
+	}
+
+	algorithm, message := _minisignAlgorithmPrehashed, minisignPrehash(data)
+	if options.Legacy {
+		algorithm, message = _minisignAlgorithmLegacy, data
+	}
+
+	signature, err := key.Signer.Sign(rand.Reader, message, crypto.Hash(0))
+	if err != nil {
+		return nil, err
+	}
+
+	globalSignature, err := key.Signer.Sign(rand.Reader, append(signature, trustedComment...), crypto.Hash(0))
+	if err != nil {
+		return nil, err
+	}
+
+	block := append([]byte(algorithm), keyNumber...)
+	block = append(block, signature...)
+
+	var encoded bytes.Buffer
+	encoded.WriteString(_minisignUntrustedPrefix + untrustedComment + "\n")
+	encoded.WriteString(base64.StdEncoding.EncodeToString(block) + "\n")
+	encoded.WriteString(_minisignTrustedPrefix + trustedComment + "\n")
+	encoded.WriteString(base64.StdEncoding.EncodeToString(globalSignature) + "\n")
+	return encoded.Bytes(), nil
+}
+
+// VerifyMinisign checks a minisign signature of data made by a key of the keyring, including the global signature
+// of the trusted comment.
+func VerifyMinisign(keyring *Keyring, data, signature []byte) (*MinisignVerification, error) {
+	lines := strings.Split(strings.TrimRight(string(signature), "\r\n"), "\n")
+	if len(lines) != 4 {
+		return nil, fmt.Errorf("%w: %d lines", ErrMalformedMinisign, len(lines))
+	}
+
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+
+	if !strings.HasPrefix(lines[0], _minisignUntrustedPrefix) || !strings.HasPrefix(lines[2], _minisignTrustedPrefix) {
+		return nil, fmt.Errorf("%w: no comments", ErrMalformedMinisign)
+	}
+	trustedComment := strings.TrimPrefix(lines[2], _minisignTrustedPrefix)
+
+	block, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil || len(block) != _minisignSignatureSize {
+		return nil, fmt.Errorf("%w: signature", ErrMalformedMinisign)
+	}
+
+	globalSignature, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil || len(globalSignature) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: global signature", ErrMalformedMinisign)
+	}
+
+	message := data
+	switch algorithm := string(block[:2]); algorithm {
+	case _minisignAlgorithmPrehashed:
+		message = minisignPrehash(data)
+	case _minisignAlgorithmLegacy:
+	default:
+		return nil, fmt.Errorf("%w: algorithm %q", ErrMalformedMinisign, algorithm)
+	}
+
+	// The key number is the key id.
+	key, ok := keyring.Lookup(hex.EncodeToString(block[2:10]))
+	if !ok {
+		return nil, fmt.Errorf("%w %x", ErrUnknownKey, block[2:10])
+	}
+
+	publicKey, ok := key.PublicKey.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoMinisignAlgorithm, key.Algorithm)
+	}
+
+	if !ed25519.Verify(publicKey, message, block[10:]) {
+		return nil, errors.New("minisign signature mismatch")
+	}
+
+	if !ed25519.Verify(publicKey, append(bytes.Clone(block[10:]), trustedComment...), globalSignature) {
+		return nil, errors.New("minisign global signature mismatch")
+	}
+
+	return &MinisignVerification{Key: key, TrustedComment: trustedComment}, nil
+}
+
+// ExportMinisignPublicKey returns the minisign public key file of an Ed25519 key, which `minisign -V -p` reads.
+func ExportMinisignPublicKey(key *Key) ([]byte, error) {
+	keyNumber, err := minisignKeyNumber(key)
+	if err != nil {
+		return nil, err
+	}
+
+	number, _ := MinisignKeyNumber(key)
+
+	block := make([]byte, 0, _minisignPublicKeySize)
+	block = append(block, _minisignAlgorithmLegacy...)
+	block = append(block, keyNumber...)
+	block = append(block, key.PublicKey.(ed25519.PublicKey)...)
+
+	var encoded bytes.Buffer
+	encoded.WriteString(_minisignUntrustedPrefix + "minisign public key " + number + "\n")
+	encoded.WriteString(base64.StdEncoding.EncodeToString(block) + "\n")
+	return encoded.Bytes(), nil
+}
+
+func minisignKeyNumber(key *Key) ([]byte, error) {
+	if _, ok := key.PublicKey.(ed25519.PublicKey); !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoMinisignAlgorithm, key.Algorithm)
+	}
+	return hex.DecodeString(key.ID)
+}
+
+func minisignPrehash(data []byte) []byte {
+	digest := blake2b.Sum512(data)
+	return digest[:]
+}
@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/blake2b"
+)
+
+func TestSignMinisign(t *testing.T) {
+	t.Parallel()
+
+	key, retired := newTestKey(t), newTestKey(t)
+	keyring := NewKeyring(key, retired)
+	data := randData(t, 1000)
+
+	for _, legacy := range []bool{false, true} {
+		signature, err := SignMinisign(key, data, MinisignOptions{Legacy: legacy, TrustedComment: "timestamp:1 file:data"})
+		assert.NoError(t, err)
+
+		lines := strings.Split(string(signature), "\n")
+		assert.Len(t, lines, 5)
+		assert.Equal(t, "trusted comment: timestamp:1 file:data", lines[2])
+
+		block, err := base64.StdEncoding.DecodeString(lines[1])
+		assert.NoError(t, err)
+		assert.Len(t, block, _minisignSignatureSize)
+
+		// The signature is a plain Ed25519 signature of the data or of its BLAKE2b-512 digest.
+		message, algorithm := data, _minisignAlgorithmLegacy
+		if !legacy {
+			digest := blake2b.Sum512(data)
+			message, algorithm = digest[:], _minisignAlgorithmPrehashed
+		}
+		assert.Equal(t, algorithm, string(block[:2]))
+		assert.True(t, ed25519.Verify(key.PublicKey.(ed25519.PublicKey), message, block[10:]))
+
+		verification, err := VerifyMinisign(keyring, data, signature)
+		assert.NoError(t, err)
+		assert.Equal(t, key.ID, verification.Key.ID)
+		assert.Equal(t, "timestamp:1 file:data", verification.TrustedComment)
+
+		_, err = VerifyMinisign(keyring, data[1:], signature)
+		assert.Error(t, err)
+
+		// The global signature covers the trusted comment.
+		tampered := strings.Replace(string(signature), "timestamp:1", "timestamp:2", 1)
+		_, err = VerifyMinisign(keyring, data, []byte(tampered))
+		assert.Error(t, err)
+
+		_, err = VerifyMinisign(NewKeyring(retired), data, signature)
+		assert.Error(t, err)
+	}
+
+	signature, err := SignMinisign(key, data, MinisignOptions{})
+	assert.NoError(t, err)
+	assert.Contains(t, string(signature), "trusted comment: timestamp:")
+
+	_, err = SignMinisign(key, data, MinisignOptions{TrustedComment: "line\nline"})
+	assert.ErrorIs(t, err, ErrMinisignComment)
+
+	_, err = SignMinisign(key.retire(), data, MinisignOptions{})
+	assert.Error(t, err)
+
+	for _, algorithm := range []Algorithm{AlgorithmECDSAP256SHA256, AlgorithmRSAPSS2048SHA256, AlgorithmMLDSA44, AlgorithmEd25519MLDSA65} {
+		_, err = SignMinisign(newTestAlgorithmKey(t, algorithm), data, MinisignOptions{})
+		assert.ErrorIs(t, err, ErrNoMinisignAlgorithm)
+
+		_, err = ExportMinisignPublicKey(newTestAlgorithmKey(t, algorithm))
+		assert.ErrorIs(t, err, ErrNoMinisignAlgorithm)
+	}
+}
+
+func TestVerifyMinisign_Malformed(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	keyring := NewKeyring(key)
+	data := randData(t, 100)
+
+	signature, err := SignMinisign(key, data, MinisignOptions{})
+	assert.NoError(t, err)
+	lines := strings.Split(string(signature), "\n")
+
+	block, err := base64.StdEncoding.DecodeString(lines[1])
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		signature string
+	}{
+		{name: "Case #1", signature: ""},
+		{name: "Case #2", signature: strings.Join(lines[:3], "\n")},
+		{name: "Case #3", signature: strings.Replace(string(signature), "untrusted comment: ", "comment: ", 1)},
+		{name: "Case #4", signature: strings.Replace(string(signature), "trusted comment: timestamp", "comment: timestamp", 1)},
+		{name: "Case #5", signature: strings.Replace(string(signature), lines[1], lines[1][4:], 1)},
+		{name: "Case #6", signature: strings.Replace(string(signature), lines[3], lines[3][4:], 1)},
+		{name: "Case #7", signature: strings.Replace(string(signature), lines[1], base64.StdEncoding.EncodeToString(append([]byte("Ex"), block[2:]...)), 1)},
+		{name: "Case #8", signature: strings.Replace(string(signature), lines[1], base64.StdEncoding.EncodeToString(append([]byte("Ed"), block[2:]...)), 1)},
+		{name: "Case #9", signature: strings.Replace(string(signature), lines[1], lines[3]+"AAAAAAAAAAAA", 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyMinisign(keyring, data, []byte(tt.signature))
+			assert.Error(t, err)
+		})
+	}
+
+	// CRLF line endings are accepted.
+	_, err = VerifyMinisign(keyring, data, []byte(strings.ReplaceAll(string(signature), "\n", "\r\n")))
+	assert.NoError(t, err)
+}
+
+func TestExportMinisignPublicKey(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+
+	publicKey, err := ExportMinisignPublicKey(key.retire())
+	assert.NoError(t, err)
+
+	keyNumber, err := MinisignKeyNumber(key)
+	assert.NoError(t, err)
+	assert.Len(t, keyNumber, 16)
+
+	lines := strings.Split(string(publicKey), "\n")
+	assert.Equal(t, "untrusted comment: minisign public key "+keyNumber, lines[0])
+
+	block, err := base64.StdEncoding.DecodeString(lines[1])
+	assert.NoError(t, err)
+	assert.Len(t, block, _minisignPublicKeySize)
+	assert.Equal(t, _minisignAlgorithmLegacy, string(block[:2]))
+	assert.Equal(t, []byte(key.PublicKey.(ed25519.PublicKey)), block[10:])
+
+	// Signatures carry the key number of the public key.
+	signature, err := SignMinisign(key, nil, MinisignOptions{})
+	assert.NoError(t, err)
+	signatureBlock, err := base64.StdEncoding.DecodeString(strings.Split(string(signature), "\n")[1])
+	assert.NoError(t, err)
+	assert.Equal(t, block[2:10], signatureBlock[2:10])
+}
@@ -0,0 +1,368 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cloudflare/circl/sign"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa44"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"github.com/cloudflare/circl/sign/mldsa/mldsa87"
+)
+
+// HybridSignatureSize is the length of an Ed25519+ML-DSA-65 composite signature.
+const HybridSignatureSize = ed25519.SignatureSize + mldsa65.SignatureSize
+
+// _hybridContext is the FIPS 204 context string of the ML-DSA component of a composite signature.
+// It keeps the component from being accepted as a standalone ML-DSA-65 signature.
+const _hybridContext = "docsign-ed25519-ml-dsa-65"
+
+var (
+	_oidMLDSA44 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 3, 17}
+	_oidMLDSA65 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 3, 18}
+	_oidMLDSA87 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 3, 19}
+	// id-composite-key of draft-ounsworth-pq-composite-keys: a sequence of component keys.
+	_oidCompositeKey = asn1.ObjectIdentifier{2, 16, 840, 1, 114027, 80, 4, 1}
+)
+
+var _mldsaSchemes = map[Algorithm]sign.Scheme{
+	AlgorithmMLDSA44: mldsa44.Scheme(),
+	AlgorithmMLDSA65: mldsa65.Scheme(),
+	AlgorithmMLDSA87: mldsa87.Scheme(),
+}
+
+var _mldsaOIDs = map[Algorithm]asn1.ObjectIdentifier{
+	AlgorithmMLDSA44: _oidMLDSA44,
+	AlgorithmMLDSA65: _oidMLDSA65,
+	AlgorithmMLDSA87: _oidMLDSA87,
+}
+
+var ErrBadCompositeKey = errors.New("malformed composite key")
+
+func mldsaAlgorithm(scheme sign.Scheme) (Algorithm, error) {
+	for algorithm, s := range _mldsaSchemes {
+		if s == scheme {
+			return algorithm, nil
+		}
+	}
+	return AlgorithmUnknown, fmt.Errorf("%w: %s", ErrUnsupportedKey, scheme.Name())
+}
+
+func mldsaAlgorithmOfOID(oid asn1.ObjectIdentifier) (Algorithm, bool) {
+	for algorithm, o := range _mldsaOIDs {
+		if o.Equal(oid) {
+			return algorithm, true
+		}
+	}
+	return AlgorithmUnknown, false
+}
+
+// HybridPublicKey verifies Ed25519+ML-DSA-65 composite signatures.
+type HybridPublicKey struct {
+	Ed25519 ed25519.PublicKey
+	MLDSA   *mldsa65.PublicKey
+}
+
+// Bytes returns the Ed25519 public key followed by the ML-DSA-65 public key.
+func (k *HybridPublicKey) Bytes() []byte {
+	raw, _ := k.MLDSA.MarshalBinary()
+	return append(append(make([]byte, 0, len(k.Ed25519)+len(raw)), k.Ed25519...), raw...)
+}
+
+func (k *HybridPublicKey) Equal(other crypto.PublicKey) bool {
+	o, ok := other.(*HybridPublicKey)
+	return ok && k.Ed25519.Equal(o.Ed25519) && k.MLDSA.Equal(o.MLDSA)
+}
+
+// Verify accepts a composite signature only if both the Ed25519 and the ML-DSA-65 components verify.
+func (k *HybridPublicKey) Verify(message, signature []byte) bool {
+	if len(signature) != HybridSignatureSize {
+		return false
+	}
+
+	classic, postQuantum := signature[:ed25519.SignatureSize], signature[ed25519.SignatureSize:]
+	classicOK := ed25519.Verify(k.Ed25519, message, classic)
+	postQuantumOK := mldsa65.Verify(k.MLDSA, message, []byte(_hybridContext), postQuantum)
+	return classicOK && postQuantumOK
+}
+
+// HybridPrivateKey signs with an Ed25519 and an ML-DSA-65 key at once.
+type HybridPrivateKey struct {
+	Ed25519 ed25519.PrivateKey
+	MLDSA   *mldsa65.PrivateKey
+}
+
+// GenerateHybridKey creates a new pair of component keys.
+func GenerateHybridKey(random io.Reader) (*HybridPrivateKey, error) {
+	_, classic, err := ed25519.GenerateKey(random)
+	if err != nil {
+		return nil, err
+	}
+
+	_, postQuantum, err := mldsa65.GenerateKey(random)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HybridPrivateKey{Ed25519: classic, MLDSA: postQuantum}, nil
+}
+
+func (k *HybridPrivateKey) Public() crypto.PublicKey {
+	return &HybridPublicKey{
+		Ed25519: k.Ed25519.Public().(ed25519.PublicKey),
+		MLDSA:   k.MLDSA.Public().(*mldsa65.PublicKey),
+	}
+}
+
+// Sign returns the Ed25519 signature of message followed by its ML-DSA-65 signature. Like Ed25519 it signs
+// messages, not digests, so opts.HashFunc() must be zero.
+func (k *HybridPrivateKey) Sign(_ io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if opts.HashFunc() != 0 {
+		return nil, fmt.Errorf("%w: hybrid keys sign messages, not digests", ErrUnsupportedKey)
+	}
+
+	signature := make([]byte, HybridSignatureSize)
+	copy(signature, ed25519.Sign(k.Ed25519, message))
+	if err := mldsa65.SignTo(k.MLDSA, message, []byte(_hybridContext), false, signature[ed25519.SignatureSize:]); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// subjectPublicKeyInfo and oneAsymmetricKey are the parts of RFC 5280 and RFC 5958 structures the service reads.
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+type oneAsymmetricKey struct {
+	Version    int
+	Algorithm  pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// marshalPKIXPublicKey extends x509.MarshalPKIXPublicKey with ML-DSA (RFC 9881) and composite keys.
+func marshalPKIXPublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	switch key := publicKey.(type) {
+	case sign.PublicKey:
+		algorithm, err := mldsaAlgorithm(key.Scheme())
+		if err != nil {
+			return nil, err
+		}
+
+		raw, err := key.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return marshalSubjectPublicKeyInfo(_mldsaOIDs[algorithm], raw)
+	case *HybridPublicKey:
+		components := make([]asn1.RawValue, 2)
+		for i, component := range []crypto.PublicKey{key.Ed25519, key.MLDSA} {
+			der, err := marshalPKIXPublicKey(component)
+			if err != nil {
+				return nil, err
+			}
+			components[i] = asn1.RawValue{FullBytes: der}
+		}
+
+		raw, err := asn1.Marshal(components)
+		if err != nil {
+			return nil, err
+		}
+		return marshalSubjectPublicKeyInfo(_oidCompositeKey, raw)
+	default:
+		return x509.MarshalPKIXPublicKey(publicKey)
+	}
+}
+
+func marshalSubjectPublicKeyInfo(oid asn1.ObjectIdentifier, raw []byte) ([]byte, error) {
+	return asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oid},
+		PublicKey: asn1.BitString{Bytes: raw, BitLength: 8 * len(raw)},
+	})
+}
+
+// parsePKIXPublicKey is the inverse of marshalPKIXPublicKey.
+func parsePKIXPublicKey(der []byte) (crypto.PublicKey, error) {
+	var info subjectPublicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) != 0 {
+		return x509.ParsePKIXPublicKey(der)
+	}
+
+	oid := info.Algorithm.Algorithm
+	if algorithm, ok := mldsaAlgorithmOfOID(oid); ok {
+		return _mldsaSchemes[algorithm].UnmarshalBinaryPublicKey(info.PublicKey.RightAlign())
+	}
+
+	if !oid.Equal(_oidCompositeKey) {
+		return x509.ParsePKIXPublicKey(der)
+	}
+
+	var components []asn1.RawValue
+	if rest, err := asn1.Unmarshal(info.PublicKey.RightAlign(), &components); err != nil || len(rest) != 0 || len(components) != 2 {
+		return nil, ErrBadCompositeKey
+	}
+
+	classic, err := x509.ParsePKIXPublicKey(components[0].FullBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCompositeKey, err)
+	}
+
+	postQuantum, err := parsePKIXPublicKey(components[1].FullBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCompositeKey, err)
+	}
+
+	key := &HybridPublicKey{}
+	var ok1, ok2 bool
+	key.Ed25519, ok1 = classic.(ed25519.PublicKey)
+	key.MLDSA, ok2 = postQuantum.(*mldsa65.PublicKey)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("%w: components %T and %T", ErrUnsupportedKey, classic, postQuantum)
+	}
+	return key, nil
+}
+
+// marshalPKCS8PrivateKey extends x509.MarshalPKCS8PrivateKey with ML-DSA and composite keys.
+// ML-DSA keys are stored in the expandedKey form of RFC 9881.
+func marshalPKCS8PrivateKey(privateKey crypto.Signer) ([]byte, error) {
+	switch key := privateKey.(type) {
+	case sign.PrivateKey:
+		algorithm, err := mldsaAlgorithm(key.Scheme())
+		if err != nil {
+			return nil, err
+		}
+
+		expanded, err := key.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, err := asn1.Marshal(expanded)
+		if err != nil {
+			return nil, err
+		}
+		return marshalOneAsymmetricKey(_mldsaOIDs[algorithm], encoded)
+	case *HybridPrivateKey:
+		components := make([]asn1.RawValue, 2)
+		for i, component := range []crypto.Signer{key.Ed25519, key.MLDSA} {
+			der, err := marshalPKCS8PrivateKey(component)
+			if err != nil {
+				return nil, err
+			}
+			components[i] = asn1.RawValue{FullBytes: der}
+		}
+
+		encoded, err := asn1.Marshal(components)
+		if err != nil {
+			return nil, err
+		}
+		return marshalOneAsymmetricKey(_oidCompositeKey, encoded)
+	default:
+		return x509.MarshalPKCS8PrivateKey(privateKey)
+	}
+}
+
+func marshalOneAsymmetricKey(oid asn1.ObjectIdentifier, encoded []byte) ([]byte, error) {
+	return asn1.Marshal(oneAsymmetricKey{Algorithm: pkix.AlgorithmIdentifier{Algorithm: oid}, PrivateKey: encoded})
+}
+
+// parsePKCS8 is the inverse of marshalPKCS8PrivateKey. ML-DSA keys may use any of the seed, expandedKey or both
+// forms of RFC 9881.
+func parsePKCS8(der []byte) (any, error) {
+	var info oneAsymmetricKey
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) != 0 {
+		return x509.ParsePKCS8PrivateKey(der)
+	}
+
+	oid := info.Algorithm.Algorithm
+	if algorithm, ok := mldsaAlgorithmOfOID(oid); ok {
+		return parseMLDSAPrivateKey(_mldsaSchemes[algorithm], info.PrivateKey)
+	}
+
+	if !oid.Equal(_oidCompositeKey) {
+		return x509.ParsePKCS8PrivateKey(der)
+	}
+
+	var components []asn1.RawValue
+	if rest, err := asn1.Unmarshal(info.PrivateKey, &components); err != nil || len(rest) != 0 || len(components) != 2 {
+		return nil, ErrBadCompositeKey
+	}
+
+	classic, err := x509.ParsePKCS8PrivateKey(components[0].FullBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCompositeKey, err)
+	}
+
+	postQuantum, err := parsePKCS8(components[1].FullBytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCompositeKey, err)
+	}
+
+	key := &HybridPrivateKey{}
+	var ok1, ok2 bool
+	key.Ed25519, ok1 = classic.(ed25519.PrivateKey)
+	key.MLDSA, ok2 = postQuantum.(*mldsa65.PrivateKey)
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("%w: components %T and %T", ErrUnsupportedKey, classic, postQuantum)
+	}
+	return key, nil
+}
+
+func parseMLDSAPrivateKey(scheme sign.Scheme, encoded []byte) (sign.PrivateKey, error) {
+	var value asn1.RawValue
+	if rest, err := asn1.Unmarshal(encoded, &value); err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: malformed %s private key", ErrUnsupportedKey, scheme.Name())
+	}
+
+	var seed, expanded []byte
+	switch {
+	case value.Class == asn1.ClassContextSpecific && value.Tag == 0:
+		seed = value.Bytes
+	case value.Class == asn1.ClassUniversal && value.Tag == asn1.TagOctetString:
+		expanded = value.Bytes
+	case value.Class == asn1.ClassUniversal && value.Tag == asn1.TagSequence:
+		var both struct {
+			Seed     []byte
+			Expanded []byte
+		}
+		if _, err := asn1.Unmarshal(encoded, &both); err != nil {
+			return nil, fmt.Errorf("%w: malformed %s private key", ErrUnsupportedKey, scheme.Name())
+		}
+		seed = both.Seed
+	default:
+		return nil, fmt.Errorf("%w: malformed %s private key", ErrUnsupportedKey, scheme.Name())
+	}
+
+	if expanded != nil {
+		return scheme.UnmarshalBinaryPrivateKey(expanded)
+	}
+
+	if len(seed) != scheme.SeedSize() {
+		return nil, fmt.Errorf("%w: %s seed of %d bytes", ErrUnsupportedKey, scheme.Name(), len(seed))
+	}
+	_, privateKey := scheme.DeriveKey(seed)
+	return privateKey, nil
+}
+
+func generateMLDSAKey(algorithm Algorithm) (crypto.Signer, error) {
+	scheme, ok := _mldsaSchemes[algorithm]
+	if !ok {
+		return nil, ErrUnknownAlgorithm
+	}
+
+	seed := make([]byte, scheme.SeedSize())
+	if _, err := io.ReadFull(rand.Reader, seed); err != nil {
+		return nil, err
+	}
+
+	_, privateKey := scheme.DeriveKey(seed)
+	return privateKey, nil
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudflare/circl/sign/mldsa/mldsa65"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMLDSAKeyFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		algorithm Algorithm
+	}{
+		{name: "Case #1", algorithm: AlgorithmMLDSA44},
+		{name: "Case #2", algorithm: AlgorithmMLDSA65},
+		{name: "Case #3", algorithm: AlgorithmMLDSA87},
+		{name: "Case #4", algorithm: AlgorithmEd25519MLDSA65},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "docsign.key")
+			publicKey, err := GenerateKeyFile(path, tt.algorithm)
+			assert.NoError(t, err)
+
+			privateKey, loadedPublicKey, err := LoadPrivateKey(path)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, KeyID(publicKey))
+			assert.Equal(t, KeyID(publicKey), KeyID(loadedPublicKey))
+
+			encoded, err := MarshalPublicKey(publicKey)
+			assert.NoError(t, err)
+			parsedPublicKey, err := ParsePublicKey(encoded)
+			assert.NoError(t, err)
+			assert.Equal(t, KeyID(publicKey), KeyID(parsedPublicKey))
+
+			message := randData(t, 100)
+			signature, err := tt.algorithm.Sign(privateKey, message)
+			assert.NoError(t, err)
+			assert.True(t, tt.algorithm.Verify(parsedPublicKey, message, signature))
+			assert.False(t, tt.algorithm.Verify(parsedPublicKey, message[1:], signature))
+		})
+	}
+}
+
+func TestHybridPublicKey_Verify(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := GenerateHybridKey(rand.Reader)
+	assert.NoError(t, err)
+	publicKey := privateKey.Public().(*HybridPublicKey)
+
+	message := randData(t, 100)
+	signature, err := AlgorithmEd25519MLDSA65.Sign(privateKey, message)
+	assert.NoError(t, err)
+	assert.Len(t, signature, HybridSignatureSize)
+	assert.True(t, publicKey.Verify(message, signature))
+
+	flip := func(i int) []byte {
+		tampered := append([]byte(nil), signature...)
+		tampered[i] ^= 1
+		return tampered
+	}
+
+	// A component signed without the composite context.
+	standalone, err := privateKey.MLDSA.Sign(rand.Reader, message, AlgorithmMLDSA65.signerOpts())
+	assert.NoError(t, err)
+
+	tests := map[string][]byte{
+		"Case #1": flip(0),
+		"Case #2": flip(ed25519.SignatureSize),
+		"Case #3": flip(HybridSignatureSize - 1),
+		"Case #4": signature[:HybridSignatureSize-1],
+		"Case #5": signature[:ed25519.SignatureSize],
+		"Case #6": append(append([]byte(nil), signature[:ed25519.SignatureSize]...), standalone...),
+		"Case #7": nil,
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			assert.False(t, publicKey.Verify(message, tt))
+		})
+	}
+
+	// The ML-DSA component is not a valid standalone ML-DSA-65 signature.
+	assert.False(t, mldsa65.Verify(publicKey.MLDSA, message, nil, signature[ed25519.SignatureSize:]))
+}
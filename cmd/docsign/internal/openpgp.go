@@ -0,0 +1,330 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"time"
+)
+
+// OpenPGP packet, algorithm and subpacket identifiers (RFC 4880).
+const (
+	_openPGPPacketSignature = 2
+	_openPGPPacketPublicKey = 6
+	_openPGPPacketUserID    = 13
+
+	_openPGPAlgorithmRSA   = 1
+	_openPGPAlgorithmECDSA = 19
+	_openPGPAlgorithmEdDSA = 22
+
+	_openPGPSignatureBinary                = 0x00
+	_openPGPSignaturePositiveCertification = 0x13
+
+	_openPGPSubpacketCreationTime      = 2
+	_openPGPSubpacketIssuer            = 16
+	_openPGPSubpacketKeyFlags          = 27
+	_openPGPSubpacketIssuerFingerprint = 33
+
+	// Certify and sign.
+	_openPGPKeyFlags = 0x03
+
+	_openPGPSignatureBlock = "PGP SIGNATURE"
+	_openPGPPublicKeyBlock = "PGP PUBLIC KEY BLOCK"
+
+	// Armor lines hold 48 bytes, 64 base64 characters.
+	_openPGPArmorLineSize = 48
+	_openPGPCRC24Init     = 0xb704ce
+	_openPGPCRC24Poly     = 0x1864cfb
+)
+
+// DefaultOpenPGPUserID is the user ID of exported OpenPGP keys unless configured otherwise.
+const DefaultOpenPGPUserID = "docsign"
+
+var ErrNoOpenPGPAlgorithm = errors.New("no OpenPGP algorithm")
+
+var (
+	_oidCurveP256       = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	_oidCurveP384       = asn1.ObjectIdentifier{1, 3, 132, 0, 34}
+	_oidCurveEd25519PGP = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11591, 15, 1}
+)
+
+var _openPGPHashes = map[crypto.Hash]byte{
+	crypto.SHA256: 8,
+	crypto.SHA384: 9,
+	crypto.SHA512: 10,
+}
+
+// openPGPKey is the v4 public key packet of a key. Its creation time is always zero, so the fingerprint depends on
+// the key material only and survives restarts and key file copies.
+type openPGPKey struct {
+	key         *Key
+	algorithm   byte
+	hash        crypto.Hash
+	body        []byte
+	fingerprint [sha1.Size]byte
+}
+
+func newOpenPGPKey(key *Key) (*openPGPKey, error) {
+	pgp := &openPGPKey{key: key}
+
+	var material []byte
+	switch publicKey := key.PublicKey.(type) {
+	case ed25519.PublicKey:
+		pgp.algorithm, pgp.hash = _openPGPAlgorithmEdDSA, crypto.SHA512
+		material = append(openPGPCurve(_oidCurveEd25519PGP), openPGPMPI(append([]byte{0x40}, publicKey...))...)
+	case *ecdsa.PublicKey:
+		oid := _oidCurveP256
+		pgp.hash = crypto.SHA256
+		if publicKey.Curve == elliptic.P384() {
+			oid, pgp.hash = _oidCurveP384, crypto.SHA384
+		}
+
+		point, err := publicKey.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		pgp.algorithm = _openPGPAlgorithmECDSA
+		material = append(openPGPCurve(oid), openPGPMPI(point.Bytes())...)
+	case *rsa.PublicKey:
+		pgp.algorithm, pgp.hash = _openPGPAlgorithmRSA, crypto.SHA256
+		material = append(openPGPMPI(publicKey.N.Bytes()), openPGPMPI(big.NewInt(int64(publicKey.E)).Bytes())...)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrNoOpenPGPAlgorithm, key.Algorithm)
+	}
+
+	// Version 4, creation time 0, algorithm, key material.
+	pgp.body = append([]byte{4, 0, 0, 0, 0, pgp.algorithm}, material...)
+
+	digest := sha1.New()
+	digest.Write(pgp.hashedKey())
+	copy(pgp.fingerprint[:], digest.Sum(nil))
+	return pgp, nil
+}
+
+// OpenPGPFingerprint returns the v4 fingerprint of the OpenPGP form of key.
+func OpenPGPFingerprint(key *Key) ([]byte, error) {
+	pgp, err := newOpenPGPKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pgp.fingerprint[:], nil
+}
+
+// OpenPGPOptions tells how SignOpenPGP encodes a signature.
+type OpenPGPOptions struct {
+	// Armor encodes the signature as an ASCII armored "PGP SIGNATURE" block instead of a binary packet.
+	Armor bool
+	// SigningTime is the signature creation time, the current time when zero.
+	SigningTime time.Time
+}
+
+// SignOpenPGP makes a v4 detached binary document signature of data, as `gpg --detach-sign` does. Ed25519, ECDSA
+// and RSA keys are supported, RSA keys sign with PKCS #1 v1.5 as OpenPGP requires.
+func SignOpenPGP(key *Key, data []byte, options OpenPGPOptions) ([]byte, error) {
+	pgp, err := newOpenPGPKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	signingTime := options.SigningTime
+	if signingTime.IsZero() {
+		signingTime = time.Now()
+	}
+
+	signature, err := pgp.sign(_openPGPSignatureBinary, data, signingTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.Armor {
+		return signature, nil
+	}
+	return openPGPArmor(_openPGPSignatureBlock, signature), nil
+}
+
+// ExportOpenPGPPublicKey returns the transferable public key of key: the public key packet, a user ID packet and
+// a positive self-certification of the user ID. key must be able to sign.
+func ExportOpenPGPPublicKey(key *Key, userID string, armored bool) ([]byte, error) {
+	pgp, err := newOpenPGPKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	// The certification hashes the key and the user ID with a 0xb4 tag and a 4 byte length.
+	certified := pgp.hashedKey()
+	certified = append(certified, 0xb4)
+	certified = binary.BigEndian.AppendUint32(certified, uint32(len(userID)))
+	certified = append(certified, userID...)
+
+	certification, err := pgp.sign(_openPGPSignaturePositiveCertification, certified, time.Unix(0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	block := openPGPPacket(_openPGPPacketPublicKey, pgp.body)
+	block = append(block, openPGPPacket(_openPGPPacketUserID, []byte(userID))...)
+	block = append(block, certification...)
+
+	if !armored {
+		return block, nil
+	}
+	return openPGPArmor(_openPGPPublicKeyBlock, block), nil
+}
+
+// hashedKey is the public key packet as hashed by fingerprints and certifications.
+func (k *openPGPKey) hashedKey() []byte {
+	hashed := []byte{0x99}
+	hashed = binary.BigEndian.AppendUint16(hashed, uint16(len(k.body)))
+	return append(hashed, k.body...)
+}
+
+// sign makes a v4 signature packet over data with creation time and issuer fingerprint hashed subpackets and
+// an issuer key ID unhashed subpacket. Certifications carry key flags as well.
+func (k *openPGPKey) sign(signatureType byte, data []byte, signingTime time.Time) ([]byte, error) {
+	if k.key.Signer == nil {
+		return nil, fmt.Errorf("key %s is %s", k.key.ID, k.key.Status)
+	}
+
+	hashed := openPGPSubpacket(_openPGPSubpacketCreationTime, binary.BigEndian.AppendUint32(nil, uint32(signingTime.Unix())))
+	if signatureType == _openPGPSignaturePositiveCertification {
+		hashed = append(hashed, openPGPSubpacket(_openPGPSubpacketKeyFlags, []byte{_openPGPKeyFlags})...)
+	}
+	hashed = append(hashed, openPGPSubpacket(_openPGPSubpacketIssuerFingerprint, append([]byte{4}, k.fingerprint[:]...))...)
+
+	fields := []byte{4, signatureType, k.algorithm, _openPGPHashes[k.hash]}
+	fields = binary.BigEndian.AppendUint16(fields, uint16(len(hashed)))
+	fields = append(fields, hashed...)
+
+	hasher := k.hash.New()
+	hasher.Write(data)
+	hasher.Write(fields)
+	hasher.Write(binary.BigEndian.AppendUint32([]byte{4, 0xff}, uint32(len(fields))))
+	digest := hasher.Sum(nil)
+
+	signature, err := k.signDigest(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	unhashed := openPGPSubpacket(_openPGPSubpacketIssuer, k.fingerprint[len(k.fingerprint)-8:])
+
+	body := fields
+	body = binary.BigEndian.AppendUint16(body, uint16(len(unhashed)))
+	body = append(body, unhashed...)
+	body = append(body, digest[:2]...)
+	body = append(body, signature...)
+	return openPGPPacket(_openPGPPacketSignature, body), nil
+}
+
+// signDigest returns the signature MPIs of digest: R and S for EdDSA and ECDSA, the PKCS #1 v1.5 signature for RSA.
+func (k *openPGPKey) signDigest(digest []byte) ([]byte, error) {
+	switch k.algorithm {
+	case _openPGPAlgorithmEdDSA:
+		// EdDSA signs the digest as the message.
+		signature, err := k.key.Signer.Sign(rand.Reader, digest, crypto.Hash(0))
+		if err != nil {
+			return nil, err
+		}
+		return append(openPGPMPI(signature[:32]), openPGPMPI(signature[32:])...), nil
+	case _openPGPAlgorithmECDSA:
+		signature, err := k.key.Signer.Sign(rand.Reader, digest, k.hash)
+		if err != nil {
+			return nil, err
+		}
+
+		var rs struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(signature, &rs); err != nil {
+			return nil, err
+		}
+		return append(openPGPMPI(rs.R.Bytes()), openPGPMPI(rs.S.Bytes())...), nil
+	default:
+		signature, err := k.key.Signer.Sign(rand.Reader, digest, k.hash)
+		if err != nil {
+			return nil, err
+		}
+		return openPGPMPI(signature), nil
+	}
+}
+
+// openPGPPacket frames body as a new format packet.
+func openPGPPacket(tag byte, body []byte) []byte {
+	packet := []byte{0xc0 | tag}
+	switch length := len(body); {
+	case length < 192:
+		packet = append(packet, byte(length))
+	case length < 8384:
+		length -= 192
+		packet = append(packet, byte(length>>8)+192, byte(length))
+	default:
+		packet = binary.BigEndian.AppendUint32(append(packet, 0xff), uint32(length))
+	}
+	return append(packet, body...)
+}
+
+// openPGPSubpacket encodes a signature subpacket shorter than 192 bytes.
+func openPGPSubpacket(subpacketType byte, data []byte) []byte {
+	return append([]byte{byte(len(data) + 1), subpacketType}, data...)
+}
+
+// openPGPMPI encodes an unsigned big endian integer as a multiprecision integer: a two byte bit count followed by
+// the bytes without leading zeros.
+func openPGPMPI(value []byte) []byte {
+	value = bytes.TrimLeft(value, "\x00")
+
+	length := 0
+	if len(value) > 0 {
+		length = (len(value)-1)*8 + bits.Len8(value[0])
+	}
+	return append(binary.BigEndian.AppendUint16(nil, uint16(length)), value...)
+}
+
+// openPGPCurve encodes a curve OID as its DER encoding without the tag, prefixed by its length.
+func openPGPCurve(oid asn1.ObjectIdentifier) []byte {
+	der, _ := asn1.Marshal(oid)
+	return der[1:]
+}
+
+// openPGPArmor encodes data as an ASCII armored block without headers (RFC 4880 6.2): base64 lines of 64 characters
+// followed by the base64 encoded CRC-24 checksum of data.
+func openPGPArmor(blockType string, data []byte) []byte {
+	var armored bytes.Buffer
+	armored.WriteString("-----BEGIN " + blockType + "-----\n\n")
+	for rest := data; len(rest) > 0; {
+		line := rest[:min(len(rest), _openPGPArmorLineSize)]
+		rest = rest[len(line):]
+		armored.WriteString(base64.StdEncoding.EncodeToString(line))
+		armored.WriteByte('\n')
+	}
+
+	crc := openPGPCRC24(data)
+	armored.WriteString("=" + base64.StdEncoding.EncodeToString([]byte{byte(crc >> 16), byte(crc >> 8), byte(crc)}) + "\n")
+	armored.WriteString("-----END " + blockType + "-----\n")
+	return armored.Bytes()
+}
+
+// openPGPCRC24 is the checksum of an armored block (RFC 4880 6.1).
+func openPGPCRC24(data []byte) uint32 {
+	crc := uint32(_openPGPCRC24Init)
+	for _, b := range data {
+		crc ^= uint32(b) << 16
+		for i := 0; i < 8; i++ {
+			crc <<= 1
+			if crc&0x1000000 != 0 {
+				crc ^= _openPGPCRC24Poly
+			}
+		}
+	}
+	return crc & 0xffffff
+}
@@ -0,0 +1,195 @@
+package internal
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func TestSignOpenPGP(t *testing.T) {
+	t.Parallel()
+
+	payload := randData(t, 1000)
+
+	// golang.org/x/crypto/openpgp reads RSA and ECDSA keys only.
+	for _, algorithm := range []Algorithm{AlgorithmECDSAP256SHA256, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256} {
+		for _, armored := range []bool{false, true} {
+			t.Run(algorithm.String(), func(t *testing.T) {
+				key := newTestAlgorithmKey(t, algorithm)
+
+				publicKey, err := ExportOpenPGPPublicKey(key, "docsign <docsign@example.com>", armored)
+				assert.NoError(t, err)
+
+				signature, err := SignOpenPGP(key, payload, OpenPGPOptions{Armor: armored})
+				assert.NoError(t, err)
+
+				readKeyRing, checkSignature := openpgp.ReadKeyRing, openpgp.CheckDetachedSignature
+				if armored {
+					readKeyRing, checkSignature = openpgp.ReadArmoredKeyRing, openpgp.CheckArmoredDetachedSignature
+				}
+
+				keyring, err := readKeyRing(bytes.NewReader(publicKey))
+				assert.NoError(t, err)
+				assert.Len(t, keyring, 1)
+				assert.Contains(t, keyring[0].Identities, "docsign <docsign@example.com>")
+
+				fingerprint, err := OpenPGPFingerprint(key)
+				assert.NoError(t, err)
+				assert.Equal(t, fingerprint, keyring[0].PrimaryKey.Fingerprint[:])
+
+				signer, err := checkSignature(keyring, bytes.NewReader(payload), bytes.NewReader(signature))
+				assert.NoError(t, err)
+				assert.Equal(t, keyring[0].PrimaryKey.KeyId, signer.PrimaryKey.KeyId)
+
+				_, err = checkSignature(keyring, bytes.NewReader(payload[1:]), bytes.NewReader(signature))
+				assert.Error(t, err)
+			})
+		}
+	}
+}
+
+func TestSignOpenPGP_Ed25519(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+	payload := randData(t, 100)
+	signingTime := time.Unix(1700000000, 0)
+
+	armored, err := SignOpenPGP(key, payload, OpenPGPOptions{Armor: true, SigningTime: signingTime})
+	assert.NoError(t, err)
+
+	binarySignature, err := SignOpenPGP(key, payload, OpenPGPOptions{SigningTime: signingTime})
+	assert.NoError(t, err)
+
+	// Ed25519 signatures are deterministic.
+	block, err := armor.Decode(bytes.NewReader(armored))
+	assert.NoError(t, err)
+	assert.Equal(t, _openPGPSignatureBlock, block.Type)
+	decoded, err := io.ReadAll(block.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, binarySignature, decoded)
+
+	fingerprint, err := OpenPGPFingerprint(key)
+	assert.NoError(t, err)
+
+	// golang.org/x/crypto/openpgp does not know EdDSA: the signature is checked by hand. The packet holds the hashed
+	// fields, the unhashed issuer subpacket, the digest prefix, R and S.
+	assert.Equal(t, byte(0xc0|_openPGPPacketSignature), binarySignature[0])
+	body := binarySignature[2:]
+	hashedLength := int(binary.BigEndian.Uint16(body[4:]))
+	fields := body[:6+hashedLength]
+	unhashed := body[len(fields)+2 : len(fields)+2+int(binary.BigEndian.Uint16(body[len(fields):]))]
+	rest := body[len(fields)+2+len(unhashed)+2:]
+
+	assert.Equal(t, []byte{4, _openPGPSignatureBinary, _openPGPAlgorithmEdDSA, 10}, fields[:4])
+	assert.Contains(t, string(fields), string(binary.BigEndian.AppendUint32(nil, uint32(signingTime.Unix()))))
+	assert.Equal(t, fingerprint[12:], unhashed[2:])
+
+	digest := sha512.New()
+	digest.Write(payload)
+	digest.Write(fields)
+	digest.Write(binary.BigEndian.AppendUint32([]byte{4, 0xff}, uint32(len(fields))))
+
+	r, rest := openPGPTestMPI(t, rest)
+	s, _ := openPGPTestMPI(t, rest)
+	rs := append(leftPad(r, 32), leftPad(s, 32)...)
+	assert.True(t, ed25519.Verify(key.PublicKey.(ed25519.PublicKey), digest.Sum(nil), rs))
+}
+
+func TestExportOpenPGPPublicKey(t *testing.T) {
+	t.Parallel()
+
+	key := newTestKey(t)
+
+	first, err := ExportOpenPGPPublicKey(key, DefaultOpenPGPUserID, false)
+	assert.NoError(t, err)
+	second, err := ExportOpenPGPPublicKey(key, DefaultOpenPGPUserID, false)
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	_, err = ExportOpenPGPPublicKey(key.retire(), DefaultOpenPGPUserID, false)
+	assert.Error(t, err)
+
+	for _, algorithm := range []Algorithm{AlgorithmMLDSA44, AlgorithmEd25519MLDSA65} {
+		_, err = ExportOpenPGPPublicKey(newTestAlgorithmKey(t, algorithm), DefaultOpenPGPUserID, false)
+		assert.ErrorIs(t, err, ErrNoOpenPGPAlgorithm)
+
+		_, err = SignOpenPGP(newTestAlgorithmKey(t, algorithm), nil, OpenPGPOptions{})
+		assert.ErrorIs(t, err, ErrNoOpenPGPAlgorithm)
+	}
+}
+
+func TestOpenPGPPacket(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		length int
+		header []byte
+	}{
+		{name: "Case #1", length: 0, header: []byte{0xc2, 0}},
+		{name: "Case #2", length: 191, header: []byte{0xc2, 191}},
+		{name: "Case #3", length: 192, header: []byte{0xc2, 192, 0}},
+		{name: "Case #4", length: 8383, header: []byte{0xc2, 223, 255}},
+		{name: "Case #5", length: 8384, header: []byte{0xc2, 0xff, 0, 0, 0x20, 0xc0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := openPGPPacket(_openPGPPacketSignature, make([]byte, tt.length))
+			assert.Equal(t, tt.header, encoded[:len(tt.header)])
+			assert.Len(t, encoded, len(tt.header)+tt.length)
+		})
+	}
+
+	assert.Equal(t, []byte{0, 0}, openPGPMPI(nil))
+	assert.Equal(t, []byte{0, 1, 1}, openPGPMPI([]byte{0, 0, 1}))
+	assert.Equal(t, []byte{0, 9, 1, 0xff}, openPGPMPI([]byte{1, 0xff}))
+}
+
+// openPGPTestMPI returns the value of the MPI at the start of data and the rest of data.
+func openPGPTestMPI(t *testing.T, data []byte) ([]byte, []byte) {
+	length := (int(binary.BigEndian.Uint16(data)) + 7) / 8
+	assert.GreaterOrEqual(t, len(data), 2+length)
+	return data[2 : 2+length], data[2+length:]
+}
+
+func leftPad(data []byte, size int) []byte {
+	return append(make([]byte, size-len(data)), data...)
+}
+
+func TestOpenPGPArmor(t *testing.T) {
+	t.Parallel()
+
+	// Check value of the CRC-24 of RFC 4880.
+	assert.Equal(t, uint32(0x21cf02), openPGPCRC24([]byte("123456789")))
+	assert.Equal(t, uint32(_openPGPCRC24Init), openPGPCRC24(nil))
+
+	for _, size := range []int{0, 1, 47, 48, 49, 96, 1000} {
+		data := randData(t, size)
+		armored := openPGPArmor(_openPGPSignatureBlock, data)
+
+		lines := bytes.Split(bytes.TrimSuffix(armored, []byte("\n")), []byte("\n"))
+		assert.Equal(t, "-----BEGIN PGP SIGNATURE-----", string(lines[0]), size)
+		assert.Empty(t, lines[1], size)
+		assert.Equal(t, "-----END PGP SIGNATURE-----", string(lines[len(lines)-1]), size)
+		for _, line := range lines[2 : len(lines)-2] {
+			assert.LessOrEqual(t, len(line), 64, size)
+		}
+		assert.Len(t, lines[len(lines)-2], 5, size)
+
+		block, err := armor.Decode(bytes.NewReader(armored))
+		assert.NoError(t, err, size)
+		decoded, err := io.ReadAll(block.Body)
+		assert.NoError(t, err, size)
+		assert.Equal(t, data, append([]byte{}, decoded...), size)
+	}
+}
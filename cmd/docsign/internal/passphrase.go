@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+const _maxPassphraseSize = 4096
+
+var (
+	ErrNoPassphrase       = errors.New("no passphrase source available")
+	ErrPassphraseMismatch = errors.New("passphrases do not match")
+)
+
+// PassphraseSource tells where a keystore passphrase comes from. Sources are tried in order:
+// the environment variable, the file descriptor and finally an interactive prompt on the terminal.
+type PassphraseSource struct {
+	// Env is the name of an environment variable. The variable is removed from the environment once read.
+	Env string
+	// FD is an open file descriptor to read the first line from, negative values disable it.
+	FD int
+	// Prompt is shown on the terminal, an empty prompt disables interactive input.
+	Prompt string
+	// Confirm asks for the passphrase twice when it is entered interactively.
+	Confirm bool
+}
+
+// ReadPassphrase returns the passphrase from the first available source.
+func ReadPassphrase(source PassphraseSource) ([]byte, error) {
+	if source.Env != "" {
+		if value, ok := os.LookupEnv(source.Env); ok && value != "" {
+			_ = os.Unsetenv(source.Env)
+			return []byte(value), nil
+		}
+	}
+
+	if source.FD >= 0 {
+		file := os.NewFile(uintptr(source.FD), fmt.Sprintf("fd%d", source.FD))
+		if file == nil {
+			return nil, fmt.Errorf("bad passphrase file descriptor %d", source.FD)
+		}
+		defer file.Close()
+		return readPassphraseLine(file)
+	}
+
+	if source.Prompt != "" && term.IsTerminal(int(os.Stdin.Fd())) {
+		passphrase, err := promptPassphrase(source.Prompt)
+		if err != nil || !source.Confirm {
+			return passphrase, err
+		}
+
+		confirmation, err := promptPassphrase("Repeat " + source.Prompt)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(passphrase, confirmation) {
+			return nil, ErrPassphraseMismatch
+		}
+		return passphrase, nil
+	}
+
+	return nil, ErrNoPassphrase
+}
+
+func readPassphraseLine(reader io.Reader) ([]byte, error) {
+	line, err := bufio.NewReader(io.LimitReader(reader, _maxPassphraseSize)).ReadBytes('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if len(line) == 0 {
+		return nil, ErrNoPassphrase
+	}
+	return line, nil
+}
+
+func promptPassphrase(prompt string) ([]byte, error) {
+	fmt.Fprintf(os.Stderr, "%s: ", prompt)
+	defer fmt.Fprintln(os.Stderr)
+
+	passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(passphrase) == 0 {
+		return nil, ErrNoPassphrase
+	}
+	return passphrase, nil
+}
@@ -2,10 +2,24 @@ package internal
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"io"
+	"net"
+	"net/http"
+	"net/url"
 
-	ed255192 "golang.org/x/crypto/ed25519"
+	"github.com/cloudflare/circl/sign"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/r4start/sign-service/pkg/proto"
 )
@@ -13,40 +27,245 @@ import (
 type GrpcDocSignServer struct {
 	pb.UnimplementedSignServiceServer
 
-	privateKey ed255192.PrivateKey
-	publicKey  ed255192.PublicKey
+	keyring     *Keyring
+	batchLimits BatchLimits
+
+	openPGPUserID string
+	ca            *CertificateAuthority
+	tsa           *TimestampAuthority
 }
 
-func NewSignServer(privateKey ed255192.PrivateKey, publicKey ed255192.PublicKey) (*GrpcDocSignServer, error) {
-	return &GrpcDocSignServer{
-		privateKey: privateKey,
-		publicKey:  publicKey,
-	}, nil
+// ServerOption configures a GrpcDocSignServer.
+type ServerOption func(*GrpcDocSignServer)
+
+// WithCertificateAuthority sets the certificate authority of SignCSR and IssueCertificate. By default there is no CA
+// key and every certificate is refused.
+func WithCertificateAuthority(ca *CertificateAuthority) ServerOption {
+	return func(server *GrpcDocSignServer) {
+		server.ca = ca
+	}
+}
+
+// WithTimestampAuthority sets the time-stamp authority of Timestamp and VerifyTimestamp. By default there is no TSA key
+// and every query is rejected.
+func WithTimestampAuthority(tsa *TimestampAuthority) ServerOption {
+	return func(server *GrpcDocSignServer) {
+		server.tsa = tsa
+	}
+}
+
+// WithOpenPGPUserID sets the user ID of exported OpenPGP keys, DefaultOpenPGPUserID by default.
+func WithOpenPGPUserID(userID string) ServerOption {
+	return func(server *GrpcDocSignServer) {
+		server.openPGPUserID = userID
+	}
+}
+
+func NewSignServer(keyring *Keyring, options ...ServerOption) (*GrpcDocSignServer, error) {
+	server := &GrpcDocSignServer{
+		keyring:       keyring,
+		batchLimits:   DefaultBatchLimits,
+		openPGPUserID: DefaultOpenPGPUserID,
+	}
+
+	for _, option := range options {
+		option(server)
+	}
+
+	if server.ca == nil {
+		ca, err := NewCertificateAuthority(nil, DefaultCertificateProfile, NewSerialRegistry())
+		if err != nil {
+			return nil, err
+		}
+		server.ca = ca
+	}
+
+	if server.tsa == nil {
+		tsa, err := NewTimestampAuthority(nil, TimestampPolicy{}, NewTimestampSerials())
+		if err != nil {
+			return nil, err
+		}
+		server.tsa = tsa
+	}
+
+	if err := server.CheckSigningKeys(keyring.Keys()...); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// CheckSigningKeys makes sure none of keys is the key of the certificate or the time-stamp authority, which must never
+// sign documents: a document may be a TBSCertificate or the signed attributes of a token of the caller's choice.
+func (server *GrpcDocSignServer) CheckSigningKeys(keys ...*Key) error {
+	for _, key := range keys {
+		if server.ca.issuer != nil && server.ca.issuer.ID == key.ID {
+			return fmt.Errorf("key %s is the key of the certificate authority", key.ID)
+		}
+		if server.tsa.key != nil && server.tsa.key.ID == key.ID {
+			return fmt.Errorf("key %s is the key of the time-stamp authority", key.ID)
+		}
+	}
+	return nil
 }
 
 func (server *GrpcDocSignServer) Sign(_ context.Context, doc *pb.Document) (*pb.DocSign, error) {
-	return &pb.DocSign{Sign: ed255192.Sign(server.privateKey, doc.Data)}, nil
+	return server.sign(doc)
 }
 
 func (server *GrpcDocSignServer) Verify(_ context.Context, req *pb.VerifyRequest) (*pb.VerifyResponse, error) {
-	return &pb.VerifyResponse{IsOk: ed255192.Verify(server.publicKey, req.Doc.Data, req.Sign.Sign)}, nil
+	response := verifyResponse(server.verify(req.GetDoc().GetData(), req.GetSign(), req.Purpose))
+	response.Id = req.Id
+	return response, nil
 }
 
 func (server *GrpcDocSignServer) SignBatch(_ context.Context, docs *pb.DocumentBatch) (*pb.DocSignBatch, error) {
-	signs := &pb.DocSignBatch{Sign: make([][]byte, len(docs.Doc))}
-	for i, doc := range docs.Doc {
-		signs.Sign[i] = ed255192.Sign(server.privateKey, doc)
+	key := server.keyring.Active()
+	documents := batchDocuments(docs)
+
+	sizes := make([]int, len(documents))
+	for i, document := range documents {
+		sizes[i] = len(document.Data)
+	}
+	errs := server.batchLimits.admit(sizes)
+
+	signs := &pb.DocSignBatch{KeyId: key.ID, Algorithm: algorithmProto(key.Algorithm), Results: make([]*pb.SignBatchResult, len(documents))}
+	for i, document := range documents {
+		signs.Results[i] = &pb.SignBatchResult{Id: document.Id}
+		if errs[i] != nil {
+			signs.Results[i].Result = &pb.SignBatchResult_Error{Error: batchItemStatus(errs[i])}
+		}
+	}
+
+	if docs.MerkleTree {
+		return signMerkleBatch(key, documents, signs)
+	}
+
+	signs.Sign = make([][]byte, len(documents))
+	for i, document := range documents {
+		if signs.Results[i].Result != nil {
+			continue
+		}
+
+		if err := checkReservedContext(document.Data); err != nil {
+			signs.Results[i].Result = &pb.SignBatchResult_Error{Error: batchItemStatus(status.Errorf(codes.InvalidArgument, "sign: %v", err))}
+			continue
+		}
+
+		sign, err := key.Sign(document.Data)
+		if err != nil {
+			signs.Results[i].Result = &pb.SignBatchResult_Error{Error: batchItemStatus(signError(err))}
+			continue
+		}
+		signs.Sign[i] = sign
+		signs.Results[i].Result = &pb.SignBatchResult_Sign{Sign: sign}
 	}
 	return signs, nil
 }
+
 func (server *GrpcDocSignServer) VerifyBatch(_ context.Context, signs *pb.VerifyBatchRequest) (*pb.VerifyBatchResponse, error) {
-	response := &pb.VerifyBatchResponse{Status: make([]bool, len(signs.Docs))}
+	sizes := make([]int, len(signs.Docs))
 	for i, sign := range signs.Docs {
-		response.Status[i] = ed255192.Verify(server.publicKey, sign.Doc.Data, sign.Sign.Sign)
+		sizes[i] = len(sign.GetDoc().GetData())
+	}
+	errs := server.batchLimits.admit(sizes)
+
+	response := &pb.VerifyBatchResponse{Status: make([]bool, len(signs.Docs)), Results: make([]*pb.VerifyResponse, len(signs.Docs))}
+	for i, sign := range signs.Docs {
+		err := errs[i]
+		if err == nil {
+			err = ValidateRequest(sign)
+		}
+
+		if err != nil {
+			response.Results[i] = &pb.VerifyResponse{Id: sign.Id, Error: batchItemStatus(err)}
+			continue
+		}
+
+		response.Results[i] = verifyResponse(server.verify(sign.Doc.Data, sign.Sign, sign.Purpose))
+		response.Results[i].Id = sign.Id
+		response.Status[i] = response.Results[i].IsOk
 	}
 	return response, nil
 }
 
+// signMerkleBatch signs the Merkle tree of the documents of a batch without an error in their results.
+func signMerkleBatch(key *Key, documents []*pb.BatchDocument, signs *pb.DocSignBatch) (*pb.DocSignBatch, error) {
+	if len(documents) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "sign batch: %v", ErrEmptyBatch)
+	}
+
+	var (
+		leaves  [][]byte
+		results []*pb.SignBatchResult
+	)
+	for i, document := range documents {
+		if signs.Results[i].Result == nil {
+			leaves = append(leaves, document.Data)
+			results = append(results, signs.Results[i])
+		}
+	}
+
+	signs.Proofs = make([]*pb.InclusionProof, len(documents))
+	for i := range signs.Proofs {
+		signs.Proofs[i] = &pb.InclusionProof{}
+	}
+
+	if len(leaves) == 0 {
+		return signs, nil
+	}
+
+	proofs, err := SignMerkleBatch(key, leaves)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	head := merkleTreeHeadProto(proofs[0].TreeHead)
+	for i, proof := range proofs {
+		results[i].Result = &pb.SignBatchResult_Proof{Proof: &pb.InclusionProof{LeafIndex: proof.LeafIndex, AuditPath: proof.AuditPath, TreeHead: head}}
+	}
+	for i, result := range signs.Results {
+		if proof, ok := result.Result.(*pb.SignBatchResult_Proof); ok {
+			signs.Proofs[i] = proof.Proof
+		}
+	}
+	return signs, nil
+}
+
+func (server *GrpcDocSignServer) VerifyInclusion(_ context.Context, req *pb.VerifyInclusionRequest) (*pb.VerifyResponse, error) {
+	if req.Doc == nil || req.Proof == nil || req.Proof.TreeHead == nil {
+		return verifyResponse(verifyResult(nil, "", AlgorithmUnknown, fmt.Errorf("%w: no document or tree head", ErrMalformedInclusionProof))), nil
+	}
+
+	proof := inclusionProofFromProto(req.Proof)
+	key, err := VerifyInclusion(server.keyring, req.Doc.Data, proof)
+	return verifyResponse(verifyResult(key, proof.TreeHead.KeyID, proof.TreeHead.Algorithm, err)), nil
+}
+
+func merkleTreeHeadProto(head *MerkleTreeHead) *pb.MerkleTreeHead {
+	return &pb.MerkleTreeHead{
+		TreeSize:  head.TreeSize,
+		RootHash:  head.RootHash,
+		Sign:      head.Signature,
+		KeyId:     head.KeyID,
+		Algorithm: algorithmProto(head.Algorithm),
+	}
+}
+
+func inclusionProofFromProto(proof *pb.InclusionProof) *InclusionProof {
+	head := proof.TreeHead
+	return &InclusionProof{
+		LeafIndex: proof.LeafIndex,
+		AuditPath: proof.AuditPath,
+		TreeHead: &MerkleTreeHead{
+			TreeSize:  head.TreeSize,
+			RootHash:  head.RootHash,
+			KeyID:     head.KeyId,
+			Algorithm: algorithmFromProto(head.Algorithm),
+			Signature: head.Sign,
+		},
+	}
+}
+
 func (server *GrpcDocSignServer) SignStream(stream pb.SignService_SignStreamServer) error {
 	for {
 		doc, err := stream.Recv()
@@ -56,8 +275,12 @@ func (server *GrpcDocSignServer) SignStream(stream pb.SignService_SignStreamServ
 			return err
 		}
 
-		sign := ed255192.Sign(server.privateKey, doc.Data)
-		if err := stream.Send(&pb.DocSign{Sign: sign}); err != nil {
+		sign, err := server.sign(doc)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(sign); err != nil {
 			return err
 		}
 	}
@@ -72,9 +295,888 @@ func (server *GrpcDocSignServer) VerifyStream(stream pb.SignService_VerifyStream
 			return err
 		}
 
-		result := ed255192.Verify(server.publicKey, doc.Doc.Data, doc.Sign.Sign)
-		if err := stream.Send(&pb.VerifyResponse{IsOk: result}); err != nil {
+		response := verifyResponse(server.verify(doc.GetDoc().GetData(), doc.GetSign(), doc.Purpose))
+		response.Id = doc.Id
+		if err := stream.Send(response); err != nil {
 			return err
 		}
 	}
 }
+
+func signError(err error) error {
+	return status.Errorf(codes.Internal, "sign: %v", err)
+}
+
+// sign signs a document with the active key, together with a SignatureEnvelope when the document asks for one.
+func (server *GrpcDocSignServer) sign(doc *pb.Document) (*pb.DocSign, error) {
+	key := server.keyring.Active()
+	if doc.Envelope == nil {
+		if err := checkReservedContext(doc.Data); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "sign: %v", err)
+		}
+
+		sign, err := key.Sign(doc.Data)
+		if err != nil {
+			return nil, signError(err)
+		}
+		return &pb.DocSign{Sign: sign, KeyId: key.ID, Algorithm: algorithmProto(key.Algorithm)}, nil
+	}
+
+	envelope := &SignatureEnvelope{Purpose: doc.Envelope.Purpose, Attributes: doc.Envelope.Attributes}
+	if doc.Envelope.ExpiresAt != nil {
+		envelope.ExpiresAt = doc.Envelope.ExpiresAt.AsTime()
+	}
+
+	sign, err := SignEnvelope(key, doc.Data, envelope)
+	if errors.Is(err, ErrInvalidEnvelope) {
+		return nil, status.Errorf(codes.InvalidArgument, "sign: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.DocSign{
+		Sign:      sign,
+		KeyId:     key.ID,
+		Algorithm: algorithmProto(key.Algorithm),
+		Envelope:  envelopeProto(envelope),
+	}, nil
+}
+
+// verify checks a signature of data, an enveloped one must also be unexpired and of the given purpose. A missing
+// signature is an empty one.
+func (server *GrpcDocSignServer) verify(data []byte, sign *pb.DocSign, purpose string) VerifyResult {
+	algorithm, err := signatureAlgorithm(sign)
+	if err != nil {
+		return verifyResult(nil, sign.GetKeyId(), algorithm, err)
+	}
+
+	if sign.GetEnvelope() != nil {
+		envelope := envelopeFromProto(sign.Envelope)
+		if sign.KeyId != "" && sign.KeyId != envelope.KeyID {
+			return verifyResult(nil, sign.KeyId, algorithm, fmt.Errorf("%w: key %s, envelope key %s", ErrInvalidEnvelope, sign.KeyId, envelope.KeyID))
+		}
+
+		key, err := VerifyEnvelope(server.keyring, data, sign.Sign, envelope, purpose)
+		return verifyResult(key, envelope.KeyID, envelope.Algorithm, err)
+	}
+
+	if purpose != "" {
+		return verifyResult(nil, sign.GetKeyId(), algorithm, fmt.Errorf("%w: the signature has no envelope", ErrPurposeMismatch))
+	}
+	return VerifySignature(server.keyring, data, sign.GetSign(), sign.GetKeyId(), algorithm)
+}
+
+// signatureAlgorithm is the algorithm sign claims, AlgorithmUnknown when it claims none.
+func signatureAlgorithm(sign *pb.DocSign) (Algorithm, error) {
+	algorithm := algorithmFromProto(sign.GetAlgorithm())
+	if algorithm == AlgorithmUnknown && sign.GetAlgorithm() != pb.Algorithm_ALGORITHM_UNSPECIFIED {
+		return AlgorithmUnknown, fmt.Errorf("%w: algorithm %s", ErrUnknownKey, sign.Algorithm)
+	}
+	return algorithm, nil
+}
+
+func verifyResponse(result VerifyResult) *pb.VerifyResponse {
+	response := &pb.VerifyResponse{
+		IsOk:      result.OK(),
+		Failure:   verifyFailureProto(result.Failure),
+		KeyId:     result.KeyID,
+		Algorithm: algorithmProto(result.Algorithm),
+	}
+	if result.Err != nil {
+		response.Detail = result.Err.Error()
+	}
+	return response
+}
+
+func verifyFailureProto(failure VerifyFailure) pb.VerifyFailure {
+	switch failure {
+	case VerifyFailureBadLength:
+		return pb.VerifyFailure_VERIFY_FAILURE_BAD_LENGTH
+	case VerifyFailureBadEncoding:
+		return pb.VerifyFailure_VERIFY_FAILURE_BAD_ENCODING
+	case VerifyFailureUnknownKey:
+		return pb.VerifyFailure_VERIFY_FAILURE_UNKNOWN_KEY
+	case VerifyFailureRevokedKey:
+		return pb.VerifyFailure_VERIFY_FAILURE_REVOKED_KEY
+	case VerifyFailureExpired:
+		return pb.VerifyFailure_VERIFY_FAILURE_EXPIRED
+	case VerifyFailureMismatch:
+		return pb.VerifyFailure_VERIFY_FAILURE_MISMATCH
+	case VerifyFailureWrongPurpose:
+		return pb.VerifyFailure_VERIFY_FAILURE_WRONG_PURPOSE
+	default:
+		return pb.VerifyFailure_VERIFY_FAILURE_UNSPECIFIED
+	}
+}
+
+func envelopeProto(envelope *SignatureEnvelope) *pb.SignatureEnvelope {
+	converted := &pb.SignatureEnvelope{
+		KeyId:      envelope.KeyID,
+		Algorithm:  algorithmProto(envelope.Algorithm),
+		SignedAt:   timestamppb.New(envelope.SignedAt),
+		Purpose:    envelope.Purpose,
+		Attributes: envelope.Attributes,
+	}
+	if !envelope.ExpiresAt.IsZero() {
+		converted.ExpiresAt = timestamppb.New(envelope.ExpiresAt)
+	}
+	return converted
+}
+
+func envelopeFromProto(envelope *pb.SignatureEnvelope) *SignatureEnvelope {
+	converted := &SignatureEnvelope{
+		KeyID:      envelope.KeyId,
+		Algorithm:  algorithmFromProto(envelope.Algorithm),
+		Purpose:    envelope.Purpose,
+		Attributes: envelope.Attributes,
+	}
+	if envelope.SignedAt != nil {
+		converted.SignedAt = envelope.SignedAt.AsTime()
+	}
+	if envelope.ExpiresAt != nil {
+		converted.ExpiresAt = envelope.ExpiresAt.AsTime()
+	}
+	return converted
+}
+
+// verifyingKey finds the key which made sign and checks it is of the algorithm sign claims. Enveloped signatures
+// are not signatures of the document or its digest and have none.
+func (server *GrpcDocSignServer) verifyingKey(sign *pb.DocSign) (*Key, error) {
+	if sign.GetEnvelope() != nil {
+		return nil, fmt.Errorf("%w: enveloped signature", ErrSignatureMismatch)
+	}
+
+	algorithm, err := signatureAlgorithm(sign)
+	if err != nil {
+		return nil, err
+	}
+	return server.keyring.verifyingKey(sign.GetKeyId(), algorithm)
+}
+
+func (server *GrpcDocSignServer) SignDigest(_ context.Context, digest *pb.Digest) (*pb.DocSign, error) {
+	key := server.keyring.Active()
+	sign, err := key.SignDigest(hashFromProto(digest.Hash), digest.Digest)
+	if errors.Is(err, ErrUnsupportedDigest) {
+		return nil, status.Errorf(codes.InvalidArgument, "sign digest: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+	return &pb.DocSign{Sign: sign, KeyId: key.ID, Algorithm: algorithmProto(key.Algorithm)}, nil
+}
+
+func (server *GrpcDocSignServer) VerifyDigest(_ context.Context, req *pb.VerifyDigestRequest) (*pb.VerifyResponse, error) {
+	key, err := server.verifyingKey(req.Sign)
+	if err != nil {
+		return verifyResponse(verifyResult(nil, req.GetSign().GetKeyId(), algorithmFromProto(req.GetSign().GetAlgorithm()), err)), nil
+	}
+
+	digest := req.GetDigest()
+	result := VerifyDigestSignature(server.keyring, hashFromProto(digest.GetHash()), digest.GetDigest(), req.Sign.GetSign(), key.ID, key.Algorithm)
+	return verifyResponse(result), nil
+}
+
+func (server *GrpcDocSignServer) SignLargeDocument(stream pb.SignService_SignLargeDocumentServer) error {
+	header, err := receiveLargeDocumentHeader(stream)
+	if err != nil {
+		return err
+	}
+
+	// Rotation while the document is uploaded does not change the signing key.
+	key := server.keyring.Active()
+	hash, err := largeDocumentHash(key, header)
+	if err != nil {
+		return err
+	}
+
+	digest, err := receiveLargeDocument(stream, hash)
+	if err != nil {
+		return err
+	}
+
+	sign, err := key.SignDigest(hash, digest)
+	if err != nil {
+		return signError(err)
+	}
+	return stream.SendAndClose(&pb.DocSign{Sign: sign, KeyId: key.ID, Algorithm: algorithmProto(key.Algorithm)})
+}
+
+func (server *GrpcDocSignServer) VerifyLargeDocument(stream pb.SignService_VerifyLargeDocumentServer) error {
+	header, err := receiveLargeDocumentHeader(stream)
+	if err != nil {
+		return err
+	}
+
+	if header.Sign == nil {
+		return status.Error(codes.InvalidArgument, "large document header without a signature")
+	}
+
+	key, err := server.verifyingKey(header.Sign)
+	if err != nil {
+		return stream.SendAndClose(verifyResponse(verifyResult(nil, header.Sign.KeyId, algorithmFromProto(header.Sign.Algorithm), err)))
+	}
+
+	hash, err := largeDocumentHash(key, header)
+	if err != nil {
+		return err
+	}
+
+	digest, err := receiveLargeDocument(stream, hash)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(verifyResponse(VerifyDigestSignature(server.keyring, hash, digest, header.Sign.Sign, key.ID, key.Algorithm)))
+}
+
+type largeDocumentStream interface {
+	Recv() (*pb.LargeDocumentChunk, error)
+}
+
+func receiveLargeDocumentHeader(stream largeDocumentStream) (*pb.LargeDocumentHeader, error) {
+	chunk, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil, status.Error(codes.InvalidArgument, "large document stream without a header")
+	} else if err != nil {
+		return nil, err
+	}
+
+	header := chunk.GetHeader()
+	if header == nil {
+		return nil, status.Error(codes.InvalidArgument, "large document stream must start with a header")
+	}
+	return header, nil
+}
+
+// largeDocumentHash picks the digest of a large document before any data is received.
+func largeDocumentHash(key *Key, header *pb.LargeDocumentHeader) (crypto.Hash, error) {
+	hash := hashFromProto(header.Hash)
+	if header.Hash == pb.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED {
+		hash = key.Algorithm.prehash()
+	}
+
+	if hash == 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "large document: %v: %s for a %s key", ErrUnsupportedDigest, header.Hash, key.Algorithm)
+	}
+
+	if _, err := key.Algorithm.digestOpts(hash, make([]byte, hash.Size())); err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "large document: %v", err)
+	}
+	return hash, nil
+}
+
+// receiveLargeDocument hashes data chunks until the client closes the stream.
+func receiveLargeDocument(stream largeDocumentStream, hash crypto.Hash) ([]byte, error) {
+	hasher := hash.New()
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return hasher.Sum(nil), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		if chunk.GetHeader() != nil {
+			return nil, status.Error(codes.InvalidArgument, "unexpected large document header")
+		}
+		hasher.Write(chunk.GetData())
+	}
+}
+
+func (server *GrpcDocSignServer) SignJWS(_ context.Context, req *pb.SignJWSRequest) (*pb.SignJWSResponse, error) {
+	key := server.keyring.Active()
+	jws, err := SignJWS(key, req.Payload, JWSOptions{
+		Serialization: jwsSerializationFromProto(req.Serialization),
+		Detached:      req.Detached,
+		Unencoded:     req.UnencodedPayload,
+		Type:          req.Type,
+		ContentType:   req.ContentType,
+	})
+	if errors.Is(err, ErrNoJWSAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sign JWS: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+	return &pb.SignJWSResponse{Jws: jws, KeyId: key.ID, Algorithm: algorithmProto(key.Algorithm)}, nil
+}
+
+func (server *GrpcDocSignServer) VerifyJWS(_ context.Context, req *pb.VerifyJWSRequest) (*pb.VerifyJWSResponse, error) {
+	key, payload, err := VerifyJWS(server.keyring, req.Jws, req.Payload)
+	if err != nil {
+		return &pb.VerifyJWSResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+	return &pb.VerifyJWSResponse{IsOk: true, KeyId: key.ID, Payload: payload}, nil
+}
+
+func (server *GrpcDocSignServer) SignCOSE(_ context.Context, req *pb.SignCOSERequest) (*pb.SignCOSEResponse, error) {
+	keys, err := server.signers(req.KeyIds)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := SignCOSE(keys, req.Payload, COSEOptions{
+		ContentType: req.ContentType,
+		ExternalAAD: req.ExternalAad,
+		Detached:    req.Detached,
+	})
+	if errors.Is(err, ErrNoCOSEAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sign COSE: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	response := &pb.SignCOSEResponse{Message: message, KeyIds: make([]string, len(keys))}
+	for i, key := range keys {
+		response.KeyIds[i] = key.ID
+	}
+	return response, nil
+}
+
+func (server *GrpcDocSignServer) VerifyCOSE(_ context.Context, req *pb.VerifyCOSERequest) (*pb.VerifyCOSEResponse, error) {
+	verification, err := VerifyCOSE(server.keyring, req.Message, req.Payload, req.ExternalAad)
+	if err != nil {
+		return &pb.VerifyCOSEResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+
+	response := &pb.VerifyCOSEResponse{
+		IsOk:        true,
+		KeyIds:      make([]string, len(verification.Keys)),
+		Payload:     verification.Payload,
+		ContentType: verification.ContentType,
+	}
+	for i, key := range verification.Keys {
+		response.KeyIds[i] = key.ID
+	}
+	return response, nil
+}
+
+func (server *GrpcDocSignServer) SignCMS(_ context.Context, req *pb.SignCMSRequest) (*pb.SignCMSResponse, error) {
+	key := server.keyring.Active()
+	signedData, err := SignCMS(key, req.Payload, CMSOptions{Detached: req.Detached})
+	if errors.Is(err, ErrNoCMSAlgorithm) || errors.Is(err, ErrNoCertificate) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sign CMS: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.SignCMSResponse{SignedData: signedData, KeyId: key.ID, Algorithm: algorithmProto(key.Algorithm)}, nil
+}
+
+func (server *GrpcDocSignServer) VerifyCMS(_ context.Context, req *pb.VerifyCMSRequest) (*pb.VerifyCMSResponse, error) {
+	verification, err := VerifyCMS(server.keyring, req.SignedData, req.Payload)
+	if err != nil {
+		return &pb.VerifyCMSResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+
+	return &pb.VerifyCMSResponse{
+		IsOk:        true,
+		KeyId:       verification.Key.ID,
+		Payload:     verification.Payload,
+		SigningTime: timestamppb.New(verification.SigningTime),
+	}, nil
+}
+
+func (server *GrpcDocSignServer) SignOpenPGP(_ context.Context, req *pb.SignOpenPGPRequest) (*pb.SignOpenPGPResponse, error) {
+	key := server.keyring.Active()
+	signature, err := SignOpenPGP(key, req.GetDoc().GetData(), OpenPGPOptions{Armor: req.Armor})
+	if errors.Is(err, ErrNoOpenPGPAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sign OpenPGP: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	fingerprint, err := OpenPGPFingerprint(key)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.SignOpenPGPResponse{Signature: signature, KeyId: key.ID, Fingerprint: fmt.Sprintf("%X", fingerprint)}, nil
+}
+
+func (server *GrpcDocSignServer) GetOpenPGPPublicKey(_ context.Context, req *pb.GetOpenPGPPublicKeyRequest) (*pb.OpenPGPPublicKey, error) {
+	if _, ok := server.keyring.Lookup(req.KeyId); !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown key %q", req.KeyId)
+	}
+
+	// The user ID certification is a signature, so only keys which sign can be exported.
+	key, ok := server.keyring.Signer(req.KeyId)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "key %q is retired", req.KeyId)
+	}
+
+	block, err := ExportOpenPGPPublicKey(key, server.openPGPUserID, req.Armor)
+	if errors.Is(err, ErrNoOpenPGPAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "export OpenPGP key: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	fingerprint, err := OpenPGPFingerprint(key)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.OpenPGPPublicKey{Key: block, KeyId: key.ID, Fingerprint: fmt.Sprintf("%X", fingerprint)}, nil
+}
+
+func (server *GrpcDocSignServer) SignSSH(_ context.Context, req *pb.SignSSHRequest) (*pb.SignSSHResponse, error) {
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty namespace")
+	}
+
+	key := server.keyring.Active()
+	signature, err := SignSSH(key, req.Namespace, req.Data)
+	if errors.Is(err, ErrNoSSHAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sign SSH: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.SignSSHResponse{
+		Signature: string(signature.Armor()),
+		KeyId:     key.ID,
+		PublicKey: authorizedKey(signature.PublicKey),
+	}, nil
+}
+
+func (server *GrpcDocSignServer) VerifySSH(_ context.Context, req *pb.VerifySSHRequest) (*pb.VerifySSHResponse, error) {
+	key, err := VerifySSH(server.keyring, req.Namespace, req.Data, []byte(req.Signature))
+	if err != nil {
+		return &pb.VerifySSHResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+	return &pb.VerifySSHResponse{IsOk: true, KeyId: key.ID}, nil
+}
+
+func (server *GrpcDocSignServer) SignMinisign(_ context.Context, req *pb.SignMinisignRequest) (*pb.SignMinisignResponse, error) {
+	key := server.keyring.Active()
+	options := MinisignOptions{Legacy: req.Legacy, TrustedComment: req.TrustedComment, UntrustedComment: req.UntrustedComment}
+	signature, err := SignMinisign(key, req.Data, options)
+	if errors.Is(err, ErrNoMinisignAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "sign minisign: %v", err)
+	} else if errors.Is(err, ErrMinisignComment) {
+		return nil, status.Errorf(codes.InvalidArgument, "sign minisign: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.SignMinisignResponse{Signature: string(signature), KeyId: key.ID}, nil
+}
+
+func (server *GrpcDocSignServer) VerifyMinisign(_ context.Context, req *pb.VerifyMinisignRequest) (*pb.VerifyMinisignResponse, error) {
+	verification, err := VerifyMinisign(server.keyring, req.Data, []byte(req.Signature))
+	if err != nil {
+		return &pb.VerifyMinisignResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+	return &pb.VerifyMinisignResponse{IsOk: true, KeyId: verification.Key.ID, TrustedComment: verification.TrustedComment}, nil
+}
+
+func (server *GrpcDocSignServer) GetMinisignPublicKey(_ context.Context, req *pb.GetMinisignPublicKeyRequest) (*pb.MinisignPublicKey, error) {
+	key, ok := server.keyring.Lookup(req.KeyId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown key %q", req.KeyId)
+	}
+
+	block, err := ExportMinisignPublicKey(key)
+	if errors.Is(err, ErrNoMinisignAlgorithm) {
+		return nil, status.Errorf(codes.FailedPrecondition, "export minisign key: %v", err)
+	} else if err != nil {
+		return nil, signError(err)
+	}
+
+	keyNumber, err := MinisignKeyNumber(key)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.MinisignPublicKey{Key: string(block), KeyId: key.ID, KeyNumber: keyNumber}, nil
+}
+
+func (server *GrpcDocSignServer) SignDSSE(_ context.Context, req *pb.SignDSSERequest) (*pb.SignDSSEResponse, error) {
+	if req.PayloadType == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty payload type")
+	}
+
+	keys, err := server.signers(req.KeyIds)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope, err := SignDSSE(keys, req.PayloadType, req.Payload)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	response := &pb.SignDSSEResponse{Envelope: envelope, KeyIds: make([]string, len(keys))}
+	for i, key := range keys {
+		response.KeyIds[i] = key.ID
+	}
+	return response, nil
+}
+
+func (server *GrpcDocSignServer) VerifyDSSE(_ context.Context, req *pb.VerifyDSSERequest) (*pb.VerifyDSSEResponse, error) {
+	verification, err := VerifyDSSE(server.keyring, req.Envelope)
+	if err != nil {
+		return &pb.VerifyDSSEResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+
+	response := &pb.VerifyDSSEResponse{
+		IsOk:        true,
+		KeyIds:      make([]string, len(verification.Keys)),
+		PayloadType: verification.PayloadType,
+		Payload:     verification.Payload,
+	}
+	for i, key := range verification.Keys {
+		response.KeyIds[i] = key.ID
+	}
+	return response, nil
+}
+
+func (server *GrpcDocSignServer) SignCSR(_ context.Context, req *pb.SignCSRRequest) (*pb.IssuedCertificate, error) {
+	request, err := ParseCertificateRequest(req.Csr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sign CSR: %v", err)
+	}
+
+	certificate, err := server.ca.SignCSR(request)
+	if err != nil {
+		return nil, issueError(err)
+	}
+	return issuedCertificateProto(server.ca.issuer, certificate), nil
+}
+
+func (server *GrpcDocSignServer) IssueCertificate(_ context.Context, req *pb.IssueCertificateRequest) (*pb.IssuedCertificate, error) {
+	publicKey, err := ParseSubjectPublicKey(req.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue certificate: %v", err)
+	}
+
+	template, err := certificateTemplateFromProto(req.Template)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue certificate: %v", err)
+	}
+
+	certificate, err := server.ca.Issue(publicKey, template)
+	if err != nil {
+		return nil, issueError(err)
+	}
+	return issuedCertificateProto(server.ca.issuer, certificate), nil
+}
+
+func issueError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidCertificateRequest):
+		return status.Errorf(codes.InvalidArgument, "issue certificate: %v", err)
+	case errors.Is(err, ErrNoIssuerCertificate), errors.Is(err, ErrNoX509Algorithm):
+		return status.Errorf(codes.FailedPrecondition, "issue certificate: %v", err)
+	default:
+		return signError(err)
+	}
+}
+
+func certificateTemplateFromProto(template *pb.CertificateTemplate) (CertificateTemplate, error) {
+	result := CertificateTemplate{
+		Subject: pkix.Name{
+			CommonName:         template.GetCommonName(),
+			Organization:       template.GetOrganization(),
+			OrganizationalUnit: template.GetOrganizationalUnit(),
+			Country:            template.GetCountry(),
+			Province:           template.GetProvince(),
+			Locality:           template.GetLocality(),
+		},
+		DNSNames:       template.GetDnsNames(),
+		EmailAddresses: template.GetEmailAddresses(),
+	}
+
+	for _, address := range template.GetIpAddresses() {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			return CertificateTemplate{}, fmt.Errorf("invalid IP address %q", address)
+		}
+		result.IPAddresses = append(result.IPAddresses, ip)
+	}
+
+	for _, uri := range template.GetUris() {
+		parsed, err := url.Parse(uri)
+		if err != nil || !parsed.IsAbs() {
+			return CertificateTemplate{}, fmt.Errorf("invalid URI %q", uri)
+		}
+		result.URIs = append(result.URIs, parsed)
+	}
+	return result, nil
+}
+
+func issuedCertificateProto(issuer *Key, certificate *x509.Certificate) *pb.IssuedCertificate {
+	chain := pem.EncodeToMemory(&pem.Block{Type: _certificatePemType, Bytes: certificate.Raw})
+	for _, parent := range issuer.Certificates {
+		chain = append(chain, pem.EncodeToMemory(&pem.Block{Type: _certificatePemType, Bytes: parent.Raw})...)
+	}
+
+	return &pb.IssuedCertificate{
+		Certificate:  certificate.Raw,
+		Pem:          string(chain),
+		SerialNumber: certificate.SerialNumber.Text(16),
+		NotBefore:    timestamppb.New(certificate.NotBefore),
+		NotAfter:     timestamppb.New(certificate.NotAfter),
+		KeyId:        issuer.ID,
+	}
+}
+
+func (server *GrpcDocSignServer) Timestamp(_ context.Context, req *pb.TimestampRequest) (*pb.TimestampResponse, error) {
+	var token *TimestampToken
+	request, err := ParseTimestampRequest(req.Query)
+	if err == nil {
+		token, err = server.tsa.Timestamp(request)
+	}
+
+	var failure *TimestampFailure
+	switch {
+	case errors.As(err, &failure):
+		response, err := TimestampRejection(failure)
+		if err != nil {
+			return nil, signError(err)
+		}
+		return &pb.TimestampResponse{Response: response, Failure: failure.Reason}, nil
+	case errors.Is(err, ErrNoTimestampPolicy), errors.Is(err, ErrNoTimestampCertificate),
+		errors.Is(err, ErrNoCertificate), errors.Is(err, ErrNoCMSAlgorithm):
+		return nil, status.Errorf(codes.FailedPrecondition, "timestamp: %v", err)
+	case err != nil:
+		return nil, signError(err)
+	}
+
+	response, err := TimestampResponse(token)
+	if err != nil {
+		return nil, signError(err)
+	}
+
+	return &pb.TimestampResponse{
+		Response:     response,
+		Granted:      true,
+		Token:        token.Token,
+		SerialNumber: token.SerialNumber.Text(16),
+		GenTime:      timestamppb.New(token.GenTime),
+		Policy:       token.Policy.String(),
+		KeyId:        token.Key.ID,
+	}, nil
+}
+
+func (server *GrpcDocSignServer) VerifyTimestamp(_ context.Context, req *pb.VerifyTimestampRequest) (*pb.VerifyTimestampResponse, error) {
+	token, err := server.tsa.Verify(req.Token, req.Data)
+	if err != nil {
+		return &pb.VerifyTimestampResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+
+	response := &pb.VerifyTimestampResponse{
+		IsOk:         true,
+		KeyId:        token.Key.ID,
+		SerialNumber: token.SerialNumber.Text(16),
+		GenTime:      timestamppb.New(token.GenTime),
+		Policy:       token.Policy.String(),
+	}
+	if token.Nonce != nil {
+		response.Nonce = token.Nonce.Text(16)
+	}
+	return response, nil
+}
+
+func (server *GrpcDocSignServer) SignHTTPMessage(_ context.Context, req *pb.SignHTTPMessageRequest) (*pb.SignHTTPMessageResponse, error) {
+	message, err := httpMessageFromProto(req.Message)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "sign HTTP message: %v", err)
+	}
+
+	options := HTTPSignatureOptions{Label: req.Label, Components: req.Components, Nonce: req.Nonce, Tag: req.Tag}
+	if req.Expires != nil {
+		options.Expires = req.Expires.AsTime()
+	}
+
+	key := server.keyring.Active()
+	signature, err := SignHTTPMessage(key, message, options)
+	switch {
+	case errors.Is(err, ErrInvalidHTTPComponent):
+		return nil, status.Errorf(codes.InvalidArgument, "sign HTTP message: %v", err)
+	case errors.Is(err, ErrNoHTTPSignatureAlgorithm):
+		return nil, status.Errorf(codes.FailedPrecondition, "sign HTTP message: %v", err)
+	case err != nil:
+		return nil, signError(err)
+	}
+
+	return &pb.SignHTTPMessageResponse{
+		SignatureInput: signature.SignatureInput,
+		Signature:      signature.Signature,
+		SignatureBase:  signature.Base,
+		ContentDigest:  signature.ContentDigest,
+		KeyId:          key.ID,
+	}, nil
+}
+
+func (server *GrpcDocSignServer) VerifyHTTPMessage(_ context.Context, req *pb.VerifyHTTPMessageRequest) (*pb.VerifyHTTPMessageResponse, error) {
+	message, err := httpMessageFromProto(req.Message)
+	if err != nil {
+		return &pb.VerifyHTTPMessageResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+
+	verification, err := VerifyHTTPMessage(server.keyring, message, req.Label, req.RequiredComponents)
+	if err != nil {
+		return &pb.VerifyHTTPMessageResponse{IsOk: false, Failure: verifyFailureProto(VerifyFailureOf(err)), Detail: err.Error()}, nil
+	}
+
+	response := &pb.VerifyHTTPMessageResponse{
+		IsOk:       true,
+		KeyId:      verification.Key.ID,
+		Label:      verification.Label,
+		Components: verification.Components,
+		Nonce:      verification.Nonce,
+		Tag:        verification.Tag,
+	}
+	if !verification.Created.IsZero() {
+		response.Created = timestamppb.New(verification.Created)
+	}
+	if !verification.Expires.IsZero() {
+		response.Expires = timestamppb.New(verification.Expires)
+	}
+	return response, nil
+}
+
+func httpMessageFromProto(message *pb.HTTPMessage) (*HTTPMessage, error) {
+	if message == nil {
+		return nil, errors.New("no message")
+	}
+
+	converted := &HTTPMessage{Method: message.Method, Status: int(message.Status), Header: http.Header{}}
+	if message.TargetUri != "" {
+		target, err := url.Parse(message.TargetUri)
+		if err != nil || !target.IsAbs() || target.Host == "" {
+			return nil, fmt.Errorf("target URI %q is not an absolute URI", message.TargetUri)
+		}
+		converted.TargetURI = target
+	}
+
+	for _, field := range message.Fields {
+		converted.Header.Add(field.Name, field.Value)
+	}
+
+	if message.HasContent || len(message.Content) != 0 {
+		converted.Content = append([]byte{}, message.Content...)
+	}
+	return converted, nil
+}
+
+// signers resolves the keys of a multi-signer request, the active key when ids is empty.
+func (server *GrpcDocSignServer) signers(ids []string) ([]*Key, error) {
+	if len(ids) == 0 {
+		return []*Key{server.keyring.Active()}, nil
+	}
+
+	keys := make([]*Key, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate key %q", id)
+		}
+		seen[id] = true
+
+		if _, ok := server.keyring.Lookup(id); !ok {
+			return nil, status.Errorf(codes.NotFound, "unknown key %q", id)
+		}
+
+		key, ok := server.keyring.Signer(id)
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "key %q is retired", id)
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func jwsSerializationFromProto(serialization pb.JWSSerialization) JWSSerialization {
+	switch serialization {
+	case pb.JWSSerialization_JWS_SERIALIZATION_FLATTENED_JSON:
+		return JWSFlattenedJSON
+	case pb.JWSSerialization_JWS_SERIALIZATION_GENERAL_JSON:
+		return JWSGeneralJSON
+	default:
+		return JWSCompact
+	}
+}
+
+func (server *GrpcDocSignServer) GetPublicKey(_ context.Context, req *pb.GetPublicKeyRequest) (*pb.PublicKey, error) {
+	key, ok := server.keyring.Lookup(req.KeyId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown key %q", req.KeyId)
+	}
+	return publicKeyProto(key)
+}
+
+func (server *GrpcDocSignServer) ListKeys(context.Context, *pb.ListKeysRequest) (*pb.ListKeysResponse, error) {
+	keys := server.keyring.Keys()
+	response := &pb.ListKeysResponse{Keys: make([]*pb.PublicKey, len(keys))}
+	for i, key := range keys {
+		publicKey, err := publicKeyProto(key)
+		if err != nil {
+			return nil, err
+		}
+		response.Keys[i] = publicKey
+	}
+	return response, nil
+}
+
+func publicKeyProto(key *Key) (*pb.PublicKey, error) {
+	spki, err := marshalPKIXPublicKey(key.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal key %s: %v", key.ID, err)
+	}
+
+	raw, err := rawPublicKey(key.PublicKey)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "marshal key %s: %v", key.ID, err)
+	}
+
+	var sshAuthorizedKey string
+	if sshKey, err := SSHPublicKey(key); err == nil {
+		sshAuthorizedKey = authorizedKey(sshKey)
+	}
+
+	return &pb.PublicKey{
+		KeyId:     key.ID,
+		Algorithm: algorithmProto(key.Algorithm),
+		Raw:       raw,
+		Spki:      spki,
+		Pem:       string(pem.EncodeToMemory(&pem.Block{Type: _publicKeyPemType, Bytes: spki})),
+		CreatedAt: timestamppb.New(key.CreatedAt),
+		Status:    keyStatusProto(key.Status),
+		Ssh:       sshAuthorizedKey,
+	}, nil
+}
+
+// rawPublicKey encodes a key the way PublicKey.raw documents it.
+func rawPublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return key, nil
+	case *ecdsa.PublicKey:
+		ecdhKey, err := key.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		return ecdhKey.Bytes(), nil
+	case *rsa.PublicKey:
+		return x509.MarshalPKCS1PublicKey(key), nil
+	case sign.PublicKey:
+		return key.MarshalBinary()
+	case *HybridPublicKey:
+		return key.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, publicKey)
+	}
+}
+
+func keyStatusProto(keyStatus KeyStatus) pb.KeyStatus {
+	switch keyStatus {
+	case KeyStatusActive:
+		return pb.KeyStatus_KEY_STATUS_ACTIVE
+	case KeyStatusRetired:
+		return pb.KeyStatus_KEY_STATUS_RETIRED
+	case KeyStatusCosigning:
+		return pb.KeyStatus_KEY_STATUS_COSIGNING
+	default:
+		return pb.KeyStatus_KEY_STATUS_UNSPECIFIED
+	}
+}
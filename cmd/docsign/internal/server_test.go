@@ -2,89 +2,1270 @@ package internal
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"net"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
-	"golang.org/x/crypto/ed25519"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stretchr/testify/assert"
 
 	pb "github.com/r4start/sign-service/pkg/proto"
 )
 
+func newTestKey(t *testing.T) *Key {
+	return newTestAlgorithmKey(t, AlgorithmEd25519)
+}
+
+func newTestAlgorithmKey(t *testing.T, algorithm Algorithm) *Key {
+	privateKey, err := algorithm.GenerateKey()
+	assert.NoError(t, err)
+	key, err := NewActiveKey(privateKey, time.Now())
+	assert.NoError(t, err)
+	return key
+}
+
 func serve(t *testing.T, ctx context.Context) (pb.SignServiceClient, func()) {
+	return serveKeyring(t, ctx, NewKeyring(newTestKey(t)))
+}
+
+func serveKeyring(t *testing.T, ctx context.Context, keyring *Keyring, options ...ServerOption) (pb.SignServiceClient, func()) {
 	const bufSize = 1024 * 1024
 
-	lis := bufconn.Listen(bufSize)
+	lis := bufconn.Listen(bufSize)
+
+	service, err := NewSignServer(keyring, options...)
+	assert.NoError(t, err)
+
+	server := grpc.NewServer()
+	pb.RegisterSignServiceServer(server, service)
+
+	go func(t *testing.T) {
+		err := server.Serve(lis)
+		assert.NoError(t, err)
+	}(t)
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithContextDialer(
+		func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+
+	closer := func() {
+		err := lis.Close()
+		assert.NoError(t, err)
+		server.Stop()
+	}
+
+	client := pb.NewSignServiceClient(conn)
+
+	return client, closer
+}
+
+func randData(t *testing.T, size int) []byte {
+	b := make([]byte, size)
+	_, err := rand.Read(b)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestGrpcDocSignServer_Sign(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client, closer := serve(t, ctx)
+	defer closer()
+
+	tests := map[string]*pb.Document{
+		"Case #1": {Data: randData(t, 17)},
+		"Case #2": {Data: randData(t, 1024)},
+		"Case #3": {Data: randData(t, 1024*1024)},
+		"Case #4": {Data: nil},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			sign, err := client.Sign(ctx, tt)
+			assert.NoError(t, err)
+
+			verification, err := client.Verify(ctx, &pb.VerifyRequest{Sign: sign, Doc: tt})
+			assert.NoError(t, err)
+			assert.True(t, verification.IsOk)
+		})
+	}
+}
+
+func TestGrpcDocSignServer_Envelope(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := newTestKey(t)
+	client, closer := serveKeyring(t, ctx, NewKeyring(key))
+	defer closer()
+
+	doc := &pb.Document{
+		Data: randData(t, 100),
+		Envelope: &pb.EnvelopeOptions{
+			ExpiresAt:  timestamppb.New(time.Now().Add(time.Hour)),
+			Purpose:    "release",
+			Attributes: map[string]string{"build": "1234"},
+		},
+	}
+
+	sign, err := client.Sign(ctx, doc)
+	assert.NoError(t, err)
+	assert.Equal(t, key.ID, sign.KeyId)
+	assert.Equal(t, key.ID, sign.Envelope.KeyId)
+	assert.Equal(t, pb.Algorithm_ALGORITHM_ED25519, sign.Envelope.Algorithm)
+	assert.WithinDuration(t, time.Now(), sign.Envelope.SignedAt.AsTime(), time.Minute)
+	assert.Equal(t, doc.Envelope.ExpiresAt.AsTime(), sign.Envelope.ExpiresAt.AsTime())
+	assert.Equal(t, "release", sign.Envelope.Purpose)
+	assert.Equal(t, doc.Envelope.Attributes, sign.Envelope.Attributes)
+
+	withAttributes := proto.Clone(sign).(*pb.DocSign)
+	withAttributes.Envelope.Attributes["build"] = "1235"
+
+	withoutEnvelope := proto.Clone(sign).(*pb.DocSign)
+	withoutEnvelope.Envelope = nil
+
+	otherKey := proto.Clone(sign).(*pb.DocSign)
+	otherKey.KeyId = newTestKey(t).ID
+
+	tests := []struct {
+		name    string
+		sign    *pb.DocSign
+		purpose string
+		isOk    bool
+	}{
+		{name: "Case #1", sign: sign, purpose: "release", isOk: true},
+		{name: "Case #2", sign: sign, purpose: "", isOk: false},
+		{name: "Case #3", sign: sign, purpose: "test", isOk: false},
+		{name: "Case #4", sign: withAttributes, purpose: "release", isOk: false},
+		{name: "Case #5", sign: withoutEnvelope, purpose: "", isOk: false},
+		{name: "Case #6", sign: otherKey, purpose: "release", isOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verification, err := client.Verify(ctx, &pb.VerifyRequest{Doc: doc, Sign: tt.sign, Purpose: tt.purpose})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.isOk, verification.IsOk)
+		})
+	}
+
+	// Signatures without an envelope have no purpose.
+	plain, err := client.Sign(ctx, &pb.Document{Data: doc.Data})
+	assert.NoError(t, err)
+	assert.Nil(t, plain.Envelope)
+
+	batch, err := client.VerifyBatch(ctx, &pb.VerifyBatchRequest{Docs: []*pb.VerifyRequest{
+		{Doc: doc, Sign: sign, Purpose: "release"},
+		{Doc: doc, Sign: plain},
+		{Doc: doc, Sign: plain, Purpose: "release"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, true, false}, batch.Status)
+
+	// Enveloped signatures are not digest signatures.
+	digest := sha512.Sum512(doc.Data)
+	verification, err := client.VerifyDigest(ctx, &pb.VerifyDigestRequest{
+		Digest: &pb.Digest{Digest: digest[:], Hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512},
+		Sign:   sign,
+	})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+
+	_, err = client.Sign(ctx, &pb.Document{Envelope: &pb.EnvelopeOptions{ExpiresAt: timestamppb.New(time.Now().Add(-time.Minute))}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// An envelope encoding signed as a raw document would verify as an enveloped signature of any purpose.
+	forged := &SignatureEnvelope{KeyID: key.ID, Algorithm: key.Algorithm, SignedAt: time.Now(), Purpose: "admin"}
+	_, err = client.Sign(ctx, &pb.Document{Data: forged.Encode(doc.Data)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	signs, err := client.SignBatch(ctx, &pb.DocumentBatch{Doc: [][]byte{forged.Encode(doc.Data), doc.Data}})
+	assert.NoError(t, err)
+	if assert.Len(t, signs.GetResults(), 2) {
+		assert.Equal(t, int32(codes.InvalidArgument), signs.Results[0].GetError().GetCode())
+		assert.NotEmpty(t, signs.Results[1].GetSign())
+	}
+}
+
+func TestGrpcDocSignServer_Rotation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	oldKey, newKey := newTestKey(t), newTestKey(t)
+	keyring := NewKeyring(oldKey)
+	client, closer := serveKeyring(t, ctx, keyring)
+	defer closer()
+
+	doc := &pb.Document{Data: randData(t, 17)}
+
+	oldSign, err := client.Sign(ctx, doc)
+	assert.NoError(t, err)
+	assert.Equal(t, oldKey.ID, oldSign.KeyId)
+
+	keyring.Rotate(newKey)
+
+	newSign, err := client.Sign(ctx, doc)
+	assert.NoError(t, err)
+	assert.Equal(t, newKey.ID, newSign.KeyId)
+
+	retired, ok := keyring.Lookup(oldKey.ID)
+	assert.True(t, ok)
+	assert.Equal(t, KeyStatusRetired, retired.Status)
+	assert.Nil(t, retired.Signer)
+
+	for _, sign := range []*pb.DocSign{oldSign, newSign} {
+		verification, err := client.Verify(ctx, &pb.VerifyRequest{Doc: doc, Sign: sign})
+		assert.NoError(t, err)
+		assert.True(t, verification.IsOk)
+	}
+
+	tests := map[string]*pb.DocSign{
+		"Case #1": {Sign: oldSign.Sign, KeyId: newKey.ID},
+		"Case #2": {Sign: oldSign.Sign, KeyId: "unknown"},
+		"Case #3": {Sign: oldSign.Sign},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			verification, err := client.Verify(ctx, &pb.VerifyRequest{Doc: doc, Sign: tt})
+			assert.NoError(t, err)
+			assert.False(t, verification.IsOk)
+		})
+	}
+}
+
+func TestGrpcDocSignServer_VerifyFailures(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	active, retired, revoked := newTestKey(t), newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256), newTestKey(t)
+	keyring := NewKeyring(active, retired.retire(), revoked.retire())
+	client, closer := serveKeyring(t, ctx, keyring)
+	defer closer()
+
+	doc := &pb.Document{Data: randData(t, 17)}
+	sign, err := client.Sign(ctx, doc)
+	assert.NoError(t, err)
+
+	retiredSignature, err := retired.Sign(doc.Data)
+	assert.NoError(t, err)
+	revokedSignature, err := revoked.Sign(doc.Data)
+	assert.NoError(t, err)
+	assert.NoError(t, keyring.Revoke(revoked.ID))
+
+	tests := []struct {
+		name      string
+		doc       *pb.Document
+		sign      *pb.DocSign
+		failure   pb.VerifyFailure
+		keyID     string
+		algorithm pb.Algorithm
+	}{
+		{name: "Case #1", doc: doc, sign: sign, failure: pb.VerifyFailure_VERIFY_FAILURE_UNSPECIFIED, keyID: active.ID, algorithm: pb.Algorithm_ALGORITHM_ED25519},
+		{name: "Case #2", doc: doc, sign: &pb.DocSign{Sign: retiredSignature, KeyId: retired.ID}, failure: pb.VerifyFailure_VERIFY_FAILURE_UNSPECIFIED, keyID: retired.ID, algorithm: pb.Algorithm_ALGORITHM_ECDSA_P256_SHA256},
+		{name: "Case #3", doc: &pb.Document{Data: doc.Data[1:]}, sign: sign, failure: pb.VerifyFailure_VERIFY_FAILURE_MISMATCH, keyID: active.ID, algorithm: pb.Algorithm_ALGORITHM_ED25519},
+		{name: "Case #4", doc: doc, sign: &pb.DocSign{Sign: sign.Sign[1:], KeyId: active.ID}, failure: pb.VerifyFailure_VERIFY_FAILURE_BAD_LENGTH, keyID: active.ID, algorithm: pb.Algorithm_ALGORITHM_ED25519},
+		{name: "Case #5", doc: doc, sign: &pb.DocSign{Sign: make([]byte, len(retiredSignature)), KeyId: retired.ID}, failure: pb.VerifyFailure_VERIFY_FAILURE_BAD_ENCODING, keyID: retired.ID, algorithm: pb.Algorithm_ALGORITHM_ECDSA_P256_SHA256},
+		{name: "Case #6", doc: doc, sign: &pb.DocSign{Sign: sign.Sign, KeyId: "unknown"}, failure: pb.VerifyFailure_VERIFY_FAILURE_UNKNOWN_KEY, keyID: "unknown"},
+		{name: "Case #7", doc: doc, sign: &pb.DocSign{Sign: sign.Sign, KeyId: active.ID, Algorithm: pb.Algorithm_ALGORITHM_ML_DSA_44}, failure: pb.VerifyFailure_VERIFY_FAILURE_UNKNOWN_KEY, keyID: active.ID, algorithm: pb.Algorithm_ALGORITHM_ML_DSA_44},
+		{name: "Case #8", doc: doc, sign: &pb.DocSign{Sign: revokedSignature, KeyId: revoked.ID}, failure: pb.VerifyFailure_VERIFY_FAILURE_REVOKED_KEY, keyID: revoked.ID},
+	}
+
+	verifyStream, err := client.VerifyStream(ctx)
+	assert.NoError(t, err)
+
+	batch := &pb.VerifyBatchRequest{Docs: make([]*pb.VerifyRequest, len(tests))}
+	for i, tt := range tests {
+		batch.Docs[i] = &pb.VerifyRequest{Doc: tt.doc, Sign: tt.sign}
+	}
+	batchVerification, err := client.VerifyBatch(ctx, batch)
+	assert.NoError(t, err)
+	assert.Len(t, batchVerification.Results, len(tests))
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verification, err := client.Verify(ctx, batch.Docs[i])
+			assert.NoError(t, err)
+
+			assert.NoError(t, verifyStream.Send(batch.Docs[i]))
+			streamVerification, err := verifyStream.Recv()
+			assert.NoError(t, err)
+
+			for _, result := range []*pb.VerifyResponse{verification, batchVerification.Results[i], streamVerification} {
+				assert.Equal(t, tt.failure, result.Failure, result.Detail)
+				assert.Equal(t, tt.failure == pb.VerifyFailure_VERIFY_FAILURE_UNSPECIFIED, result.IsOk)
+				assert.Equal(t, tt.failure != pb.VerifyFailure_VERIFY_FAILURE_UNSPECIFIED, result.Detail != "")
+				assert.Equal(t, tt.keyID, result.KeyId)
+				assert.Equal(t, tt.algorithm, result.Algorithm)
+			}
+			assert.Equal(t, verification.IsOk, batchVerification.Status[i])
+		})
+	}
+
+	digest := sha512.Sum512(doc.Data)
+	digestSign, err := client.SignDigest(ctx, &pb.Digest{Hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, Digest: digest[:]})
+	assert.NoError(t, err)
+
+	verification, err := client.VerifyDigest(ctx, &pb.VerifyDigestRequest{Digest: &pb.Digest{Hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, Digest: digest[:]}, Sign: &pb.DocSign{Sign: digestSign.Sign[:10], KeyId: digestSign.KeyId}})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_BAD_LENGTH, verification.Failure)
+	assert.Equal(t, active.ID, verification.KeyId)
+}
+
+func TestGrpcDocSignServer_PublicKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	oldKey, newKey := newTestKey(t), newTestKey(t)
+	keyring := NewKeyring(oldKey)
+	keyring.Rotate(newKey)
+	client, closer := serveKeyring(t, ctx, keyring)
+	defer closer()
+
+	active, err := client.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, newKey.ID, active.KeyId)
+	assert.Equal(t, pb.KeyStatus_KEY_STATUS_ACTIVE, active.Status)
+	assert.Equal(t, []byte(newKey.PublicKey.(ed25519.PublicKey)), active.Raw)
+
+	publicKey, err := ParsePublicKey([]byte(active.Pem))
+	assert.NoError(t, err)
+	assert.Equal(t, newKey.PublicKey, publicKey)
+
+	doc := &pb.Document{Data: randData(t, 17)}
+	sign, err := client.Sign(ctx, doc)
+	assert.NoError(t, err)
+	assert.True(t, ed25519.Verify(publicKey.(ed25519.PublicKey), doc.Data, sign.Sign))
+
+	retired, err := client.GetPublicKey(ctx, &pb.GetPublicKeyRequest{KeyId: oldKey.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.KeyStatus_KEY_STATUS_RETIRED, retired.Status)
+
+	_, err = client.GetPublicKey(ctx, &pb.GetPublicKeyRequest{KeyId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	keys, err := client.ListKeys(ctx, &pb.ListKeysRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, keys.Keys, 2)
+}
+
+func TestGrpcDocSignServer_Algorithms(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		algorithm Algorithm
+	}{
+		{name: "Case #1", algorithm: AlgorithmEd25519},
+		{name: "Case #2", algorithm: AlgorithmECDSAP256SHA256},
+		{name: "Case #3", algorithm: AlgorithmECDSAP384SHA384},
+		{name: "Case #4", algorithm: AlgorithmRSAPSS2048SHA256},
+		{name: "Case #5", algorithm: AlgorithmRSAPSS3072SHA256},
+		{name: "Case #6", algorithm: AlgorithmRSAPSS4096SHA256},
+		{name: "Case #7", algorithm: AlgorithmMLDSA44},
+		{name: "Case #8", algorithm: AlgorithmMLDSA65},
+		{name: "Case #9", algorithm: AlgorithmMLDSA87},
+		{name: "Case #10", algorithm: AlgorithmEd25519MLDSA65},
+	}
+
+	ctx := context.Background()
+	docs := [][]byte{randData(t, 17), randData(t, 1024), nil}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := newTestAlgorithmKey(t, tt.algorithm)
+			client, closer := serveKeyring(t, ctx, NewKeyring(key))
+			defer closer()
+
+			publicKey, err := client.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+			assert.NoError(t, err)
+			assert.Equal(t, algorithmProto(tt.algorithm), publicKey.Algorithm)
+
+			signs, err := client.SignBatch(ctx, &pb.DocumentBatch{Doc: docs})
+			assert.NoError(t, err)
+			assert.Equal(t, algorithmProto(tt.algorithm), signs.Algorithm)
+
+			stream, err := client.SignStream(ctx)
+			assert.NoError(t, err)
+
+			request := &pb.VerifyBatchRequest{}
+			for i, doc := range docs {
+				sign, err := client.Sign(ctx, &pb.Document{Data: doc})
+				assert.NoError(t, err)
+				assert.Equal(t, algorithmProto(tt.algorithm), sign.Algorithm)
+
+				assert.NoError(t, stream.Send(&pb.Document{Data: doc}))
+				streamSign, err := stream.Recv()
+				assert.NoError(t, err)
+
+				batchSign := &pb.DocSign{Sign: signs.Sign[i], KeyId: signs.KeyId, Algorithm: signs.Algorithm}
+				for _, sign := range []*pb.DocSign{sign, streamSign, batchSign} {
+					request.Docs = append(request.Docs, &pb.VerifyRequest{Doc: &pb.Document{Data: doc}, Sign: sign})
+				}
+
+				confused := &pb.DocSign{Sign: sign.Sign, KeyId: sign.KeyId, Algorithm: pb.Algorithm_ALGORITHM_ED25519}
+				if tt.algorithm == AlgorithmEd25519 {
+					confused.Algorithm = pb.Algorithm_ALGORITHM_ECDSA_P256_SHA256
+				}
+				verification, err := client.Verify(ctx, &pb.VerifyRequest{Doc: &pb.Document{Data: doc}, Sign: confused})
+				assert.NoError(t, err)
+				assert.False(t, verification.IsOk)
+			}
+
+			verification, err := client.VerifyBatch(ctx, request)
+			assert.NoError(t, err)
+			assert.Len(t, verification.Status, len(request.Docs))
+			for _, result := range verification.Status {
+				assert.True(t, result)
+			}
+		})
+	}
+}
+
+func TestGrpcDocSignServer_SignDigest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		algorithm  Algorithm
+		hash       pb.HashAlgorithm
+		size       int
+		shouldFail bool
+		// The digest signature is also a signature of the document.
+		verifiesDocument bool
+	}{
+		{name: "Case #1", algorithm: AlgorithmEd25519, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, size: sha512.Size},
+		{name: "Case #2", algorithm: AlgorithmEd25519, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA256, size: sha256.Size, shouldFail: true},
+		{name: "Case #3", algorithm: AlgorithmECDSAP256SHA256, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA256, size: sha256.Size, verifiesDocument: true},
+		{name: "Case #4", algorithm: AlgorithmECDSAP384SHA384, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, size: sha512.Size},
+		{name: "Case #5", algorithm: AlgorithmRSAPSS2048SHA256, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA256, size: sha256.Size, verifiesDocument: true},
+		{name: "Case #6", algorithm: AlgorithmRSAPSS2048SHA256, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, size: sha512.Size},
+		{name: "Case #7", algorithm: AlgorithmEd25519, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, size: sha256.Size, shouldFail: true},
+		{name: "Case #8", algorithm: AlgorithmEd25519, hash: pb.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED, size: sha512.Size, shouldFail: true},
+		{name: "Case #9", algorithm: AlgorithmMLDSA65, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, size: sha512.Size, shouldFail: true},
+		{name: "Case #10", algorithm: AlgorithmECDSAP384SHA384, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA384, size: sha512.Size384, verifiesDocument: true},
+		{name: "Case #11", algorithm: AlgorithmRSAPSS2048SHA256, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA384, size: sha512.Size384},
+		{name: "Case #12", algorithm: AlgorithmEd25519, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA384, size: sha512.Size384, shouldFail: true},
+	}
+
+	ctx := context.Background()
+	doc := randData(t, 1024)
+	digest256, digest384, digest512 := sha256.Sum256(doc), sha512.Sum384(doc), sha512.Sum512(doc)
+	digests := map[pb.HashAlgorithm][]byte{
+		pb.HashAlgorithm_HASH_ALGORITHM_SHA256: digest256[:],
+		pb.HashAlgorithm_HASH_ALGORITHM_SHA384: digest384[:],
+		pb.HashAlgorithm_HASH_ALGORITHM_SHA512: digest512[:],
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closer := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, tt.algorithm)))
+			defer closer()
+
+			digest := &pb.Digest{Digest: digests[tt.hash], Hash: tt.hash}
+			if len(digest.Digest) != tt.size {
+				digest.Digest = randData(t, tt.size)
+			}
+
+			sign, err := client.SignDigest(ctx, digest)
+			if tt.shouldFail {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, algorithmProto(tt.algorithm), sign.Algorithm)
+
+			verification, err := client.VerifyDigest(ctx, &pb.VerifyDigestRequest{Digest: digest, Sign: sign})
+			assert.NoError(t, err)
+			assert.True(t, verification.IsOk)
+
+			tampered := &pb.Digest{Digest: append([]byte(nil), digest.Digest...), Hash: digest.Hash}
+			tampered.Digest[0] ^= 1
+			verification, err = client.VerifyDigest(ctx, &pb.VerifyDigestRequest{Digest: tampered, Sign: sign})
+			assert.NoError(t, err)
+			assert.False(t, verification.IsOk)
+
+			verification, err = client.Verify(ctx, &pb.VerifyRequest{Doc: &pb.Document{Data: doc}, Sign: sign})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.verifiesDocument, verification.IsOk)
+		})
+	}
+}
+
+func TestGrpcDocSignServer_SignLargeDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		algorithm Algorithm
+		hash      pb.HashAlgorithm
+		chunks    []int
+	}{
+		{name: "Case #1", algorithm: AlgorithmEd25519, chunks: []int{1024, 17, 0, 3 * 1024 * 1024, 1024 * 1024}},
+		{name: "Case #2", algorithm: AlgorithmEd25519, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512},
+		{name: "Case #3", algorithm: AlgorithmECDSAP256SHA256, chunks: []int{1, 2, 3}},
+		{name: "Case #4", algorithm: AlgorithmRSAPSS2048SHA256, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA512, chunks: []int{4096, 4096}},
+		{name: "Case #5", algorithm: AlgorithmECDSAP384SHA384, chunks: []int{100, 200}},
+		{name: "Case #6", algorithm: AlgorithmECDSAP384SHA384, hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA384, chunks: []int{1}},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closer := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, tt.algorithm)))
+			defer closer()
+
+			header := &pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Header{Header: &pb.LargeDocumentHeader{Hash: tt.hash}}}
+			signStream, err := client.SignLargeDocument(ctx)
+			assert.NoError(t, err)
+			assert.NoError(t, signStream.Send(header))
+
+			hash := tt.algorithm.prehash()
+			if tt.hash != pb.HashAlgorithm_HASH_ALGORITHM_UNSPECIFIED {
+				hash = hashFromProto(tt.hash)
+			}
+			hasher := hash.New()
+
+			chunks := make([]*pb.LargeDocumentChunk, len(tt.chunks))
+			for i, size := range tt.chunks {
+				data := randData(t, size)
+				hasher.Write(data)
+				chunks[i] = &pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Data{Data: data}}
+				assert.NoError(t, signStream.Send(chunks[i]))
+			}
+
+			sign, err := signStream.CloseAndRecv()
+			assert.NoError(t, err)
+			assert.Equal(t, algorithmProto(tt.algorithm), sign.Algorithm)
+
+			digest := &pb.Digest{Digest: hasher.Sum(nil)}
+			switch hash {
+			case crypto.SHA256:
+				digest.Hash = pb.HashAlgorithm_HASH_ALGORITHM_SHA256
+			case crypto.SHA384:
+				digest.Hash = pb.HashAlgorithm_HASH_ALGORITHM_SHA384
+			case crypto.SHA512:
+				digest.Hash = pb.HashAlgorithm_HASH_ALGORITHM_SHA512
+			}
+			verification, err := client.VerifyDigest(ctx, &pb.VerifyDigestRequest{Digest: digest, Sign: sign})
+			assert.NoError(t, err)
+			assert.True(t, verification.IsOk)
+
+			verify := func(sign *pb.DocSign, chunks []*pb.LargeDocumentChunk) bool {
+				verifyStream, err := client.VerifyLargeDocument(ctx)
+				assert.NoError(t, err)
+
+				header := &pb.LargeDocumentHeader{Hash: tt.hash, Sign: sign}
+				assert.NoError(t, verifyStream.Send(&pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Header{Header: header}}))
+				for _, chunk := range chunks {
+					// The server answers early for an unknown key.
+					if err := verifyStream.Send(chunk); err != nil {
+						break
+					}
+				}
+
+				verification, err := verifyStream.CloseAndRecv()
+				assert.NoError(t, err)
+				return verification.IsOk
+			}
+
+			assert.True(t, verify(sign, chunks))
+			assert.False(t, verify(sign, append(chunks, &pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Data{Data: []byte{0}}})))
+			assert.False(t, verify(&pb.DocSign{Sign: sign.Sign, KeyId: "unknown"}, chunks))
+		})
+	}
+}
+
+func TestGrpcDocSignServer_SignLargeDocument_Malformed(t *testing.T) {
+	t.Parallel()
+
+	data := &pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Data{Data: []byte("data")}}
+	header := &pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Header{Header: &pb.LargeDocumentHeader{}}}
+
+	tests := []struct {
+		name      string
+		algorithm Algorithm
+		chunks    []*pb.LargeDocumentChunk
+	}{
+		{name: "Case #1", algorithm: AlgorithmEd25519},
+		{name: "Case #2", algorithm: AlgorithmEd25519, chunks: []*pb.LargeDocumentChunk{data}},
+		{name: "Case #3", algorithm: AlgorithmEd25519, chunks: []*pb.LargeDocumentChunk{header, data, header}},
+		{name: "Case #4", algorithm: AlgorithmEd25519, chunks: []*pb.LargeDocumentChunk{
+			{Part: &pb.LargeDocumentChunk_Header{Header: &pb.LargeDocumentHeader{Hash: pb.HashAlgorithm_HASH_ALGORITHM_SHA256}}},
+		}},
+		{name: "Case #5", algorithm: AlgorithmMLDSA65, chunks: []*pb.LargeDocumentChunk{header, data}},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, closer := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, tt.algorithm)))
+			defer closer()
+
+			stream, err := client.SignLargeDocument(ctx)
+			assert.NoError(t, err)
+			for _, chunk := range tt.chunks {
+				if err := stream.Send(chunk); err != nil {
+					break
+				}
+			}
+
+			_, err = stream.CloseAndRecv()
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	client, closer := serve(t, ctx)
+	defer closer()
+
+	stream, err := client.VerifyLargeDocument(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(header))
+	_, err = stream.CloseAndRecv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcDocSignServer_JWS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client, closer := serve(t, ctx)
+	defer closer()
+
+	payload := randData(t, 1024)
+	for _, serialization := range []pb.JWSSerialization{
+		pb.JWSSerialization_JWS_SERIALIZATION_UNSPECIFIED,
+		pb.JWSSerialization_JWS_SERIALIZATION_FLATTENED_JSON,
+		pb.JWSSerialization_JWS_SERIALIZATION_GENERAL_JSON,
+	} {
+		for _, unencoded := range []bool{false, true} {
+			jws, err := client.SignJWS(ctx, &pb.SignJWSRequest{Payload: payload, Serialization: serialization, UnencodedPayload: unencoded})
+			assert.NoError(t, err)
+			assert.Equal(t, pb.Algorithm_ALGORITHM_ED25519, jws.Algorithm)
+
+			var detached []byte
+			if unencoded {
+				detached = payload
+			}
+
+			verification, err := client.VerifyJWS(ctx, &pb.VerifyJWSRequest{Jws: jws.Jws, Payload: detached})
+			assert.NoError(t, err)
+			assert.True(t, verification.IsOk)
+			assert.Equal(t, jws.KeyId, verification.KeyId)
+			assert.Equal(t, payload, verification.Payload)
+
+			verification, err = client.VerifyJWS(ctx, &pb.VerifyJWSRequest{Jws: jws.Jws, Payload: randData(t, 16)})
+			assert.NoError(t, err)
+			assert.Equal(t, !unencoded, verification.IsOk)
+		}
+	}
+
+	other, otherCloser := serve(t, ctx)
+	defer otherCloser()
+
+	jws, err := other.SignJWS(ctx, &pb.SignJWSRequest{Payload: payload})
+	assert.NoError(t, err)
+
+	verification, err := client.VerifyJWS(ctx, &pb.VerifyJWSRequest{Jws: jws.Jws})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_UNKNOWN_KEY, verification.Failure)
+
+	verification, err = client.VerifyJWS(ctx, &pb.VerifyJWSRequest{Jws: "jws"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_BAD_ENCODING, verification.Failure)
+
+	hybridClient, hybridCloser := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, AlgorithmEd25519MLDSA65)))
+	defer hybridCloser()
+
+	_, err = hybridClient.SignJWS(ctx, &pb.SignJWSRequest{Payload: payload})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcDocSignServer_COSE(t *testing.T) {
+	t.Parallel()
+
+	active, cosigner, retired := newTestKey(t), newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256), newTestKey(t)
+	hybrid := newTestAlgorithmKey(t, AlgorithmEd25519MLDSA65)
+
+	keyring := NewKeyring(active, retired)
+	keyring.SetCosigners(cosigner, hybrid)
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, keyring)
+	defer closer()
+
+	tests := []struct {
+		name    string
+		request *pb.SignCOSERequest
+		keys    []string
+		code    codes.Code
+	}{
+		{name: "Case #1", request: &pb.SignCOSERequest{}, keys: []string{active.ID}},
+		{name: "Case #2", request: &pb.SignCOSERequest{KeyIds: []string{cosigner.ID}, ContentType: "text/plain"}, keys: []string{cosigner.ID}},
+		{name: "Case #3", request: &pb.SignCOSERequest{KeyIds: []string{active.ID, cosigner.ID}, ExternalAad: []byte("aad"), Detached: true}, keys: []string{active.ID, cosigner.ID}},
+		{name: "Case #4", request: &pb.SignCOSERequest{KeyIds: []string{active.ID, active.ID}}, code: codes.InvalidArgument},
+		{name: "Case #5", request: &pb.SignCOSERequest{KeyIds: []string{"unknown"}}, code: codes.NotFound},
+		{name: "Case #6", request: &pb.SignCOSERequest{KeyIds: []string{active.ID, retired.ID}}, code: codes.FailedPrecondition},
+		{name: "Case #7", request: &pb.SignCOSERequest{KeyIds: []string{hybrid.ID}}, code: codes.FailedPrecondition},
+	}
 
-	publicKey, privateKey, err := ed25519.GenerateKey(nil)
-	assert.NoError(t, err)
+	payload := randData(t, 1024)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.request.Payload = payload
+			signed, err := client.SignCOSE(ctx, tt.request)
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code != codes.OK {
+				return
+			}
+			assert.Equal(t, tt.keys, signed.KeyIds)
+
+			var detached []byte
+			if tt.request.Detached {
+				detached = payload
+			}
+
+			verification, err := client.VerifyCOSE(ctx, &pb.VerifyCOSERequest{Message: signed.Message, Payload: detached, ExternalAad: tt.request.ExternalAad})
+			assert.NoError(t, err)
+			assert.True(t, verification.IsOk)
+			assert.Equal(t, tt.keys, verification.KeyIds)
+			assert.Equal(t, payload, verification.Payload)
+			assert.Equal(t, tt.request.ContentType, verification.ContentType)
 
-	service, err := NewSignServer(privateKey, publicKey)
+			verification, err = client.VerifyCOSE(ctx, &pb.VerifyCOSERequest{Message: signed.Message, Payload: detached})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.request.ExternalAad == nil, verification.IsOk)
+		})
+	}
+
+	keys, err := client.ListKeys(ctx, &pb.ListKeysRequest{})
 	assert.NoError(t, err)
 
-	server := grpc.NewServer()
-	pb.RegisterSignServiceServer(server, service)
+	statuses := make(map[string]pb.KeyStatus, len(keys.Keys))
+	for _, key := range keys.Keys {
+		statuses[key.KeyId] = key.Status
+	}
+	assert.Equal(t, map[string]pb.KeyStatus{
+		active.ID:   pb.KeyStatus_KEY_STATUS_ACTIVE,
+		cosigner.ID: pb.KeyStatus_KEY_STATUS_COSIGNING,
+		hybrid.ID:   pb.KeyStatus_KEY_STATUS_COSIGNING,
+		retired.ID:  pb.KeyStatus_KEY_STATUS_RETIRED,
+	}, statuses)
+
+	// A co-signing key dropped on reload only verifies.
+	keyring.SetCosigners(hybrid)
+	key, ok := keyring.Lookup(cosigner.ID)
+	assert.True(t, ok)
+	assert.Equal(t, KeyStatusRetired, key.Status)
+	assert.Nil(t, key.Signer)
+}
 
-	go func(t *testing.T) {
-		err := server.Serve(lis)
+func TestGrpcDocSignServer_CMS(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, NewKeyring(newTestCertifiedKey(t, AlgorithmECDSAP256SHA256)))
+	defer closer()
+
+	payload := randData(t, 1024)
+	for _, detached := range []bool{false, true} {
+		signed, err := client.SignCMS(ctx, &pb.SignCMSRequest{Payload: payload, Detached: detached})
 		assert.NoError(t, err)
-	}(t)
+		assert.Equal(t, pb.Algorithm_ALGORITHM_ECDSA_P256_SHA256, signed.Algorithm)
 
-	conn, err := grpc.DialContext(ctx, "", grpc.WithContextDialer(
-		func(context.Context, string) (net.Conn, error) {
-			return lis.Dial()
-		}),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
-	assert.NoError(t, err)
+		var content []byte
+		if detached {
+			content = payload
+		}
 
-	closer := func() {
-		err := lis.Close()
+		verification, err := client.VerifyCMS(ctx, &pb.VerifyCMSRequest{SignedData: signed.SignedData, Payload: content})
 		assert.NoError(t, err)
-		server.Stop()
+		assert.True(t, verification.IsOk)
+		assert.Equal(t, signed.KeyId, verification.KeyId)
+		assert.Equal(t, payload, verification.Payload)
+		assert.WithinDuration(t, time.Now(), verification.SigningTime.AsTime(), time.Minute)
+
+		verification, err = client.VerifyCMS(ctx, &pb.VerifyCMSRequest{SignedData: signed.SignedData, Payload: randData(t, 16)})
+		assert.NoError(t, err)
+		assert.Equal(t, !detached, verification.IsOk)
 	}
 
-	client := pb.NewSignServiceClient(conn)
+	uncertifiedClient, uncertifiedCloser := serve(t, ctx)
+	defer uncertifiedCloser()
 
-	return client, closer
+	_, err := uncertifiedClient.SignCMS(ctx, &pb.SignCMSRequest{Payload: payload})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
 
-func randData(t *testing.T, size int) []byte {
-	b := make([]byte, size)
-	_, err := rand.Read(b)
+func TestGrpcDocSignServer_OpenPGP(t *testing.T) {
+	t.Parallel()
+
+	active, retired := newTestKey(t), newTestKey(t)
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, NewKeyring(active, retired))
+	defer closer()
+
+	signature, err := client.SignOpenPGP(ctx, &pb.SignOpenPGPRequest{Doc: &pb.Document{Data: randData(t, 1024)}, Armor: true})
 	assert.NoError(t, err)
-	return b
+	assert.Contains(t, string(signature.Signature), "-----BEGIN PGP SIGNATURE-----")
+	assert.Equal(t, active.ID, signature.KeyId)
+
+	publicKey, err := client.GetOpenPGPPublicKey(ctx, &pb.GetOpenPGPPublicKeyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, signature.Fingerprint, publicKey.Fingerprint)
+	assert.Len(t, publicKey.Fingerprint, 40)
+	assert.Contains(t, string(publicKey.Key), DefaultOpenPGPUserID)
+
+	_, err = client.GetOpenPGPPublicKey(ctx, &pb.GetOpenPGPPublicKeyRequest{KeyId: retired.ID})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.GetOpenPGPPublicKey(ctx, &pb.GetOpenPGPPublicKeyRequest{KeyId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mldsaClient, mldsaCloser := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, AlgorithmMLDSA65)))
+	defer mldsaCloser()
+
+	_, err = mldsaClient.SignOpenPGP(ctx, &pb.SignOpenPGPRequest{Doc: &pb.Document{Data: randData(t, 16)}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = mldsaClient.GetOpenPGPPublicKey(ctx, &pb.GetOpenPGPPublicKeyRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
 
-func TestGrpcDocSignServer_Sign(t *testing.T) {
+func TestGrpcDocSignServer_SSH(t *testing.T) {
 	t.Parallel()
 
+	active, retired := newTestKey(t), newTestKey(t)
+
 	ctx := context.Background()
-	client, closer := serve(t, ctx)
+	client, closer := serveKeyring(t, ctx, NewKeyring(active, retired))
 	defer closer()
 
-	tests := map[string]*pb.Document{
-		"Case #1": {Data: randData(t, 17)},
-		"Case #2": {Data: randData(t, 1024)},
-		"Case #3": {Data: randData(t, 1024*1024)},
-		"Case #4": {Data: nil},
+	data := randData(t, 1024)
+	signature, err := client.SignSSH(ctx, &pb.SignSSHRequest{Data: data, Namespace: "git"})
+	assert.NoError(t, err)
+	assert.Contains(t, signature.Signature, "-----BEGIN SSH SIGNATURE-----")
+	assert.Equal(t, active.ID, signature.KeyId)
+
+	publicKey, err := client.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, signature.PublicKey, publicKey.Ssh)
+	assert.True(t, strings.HasPrefix(publicKey.Ssh, "ssh-ed25519 "))
+
+	verification, err := client.VerifySSH(ctx, &pb.VerifySSHRequest{Data: data, Signature: signature.Signature, Namespace: "git"})
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+	assert.Equal(t, active.ID, verification.KeyId)
+
+	verification, err = client.VerifySSH(ctx, &pb.VerifySSHRequest{Data: data, Signature: signature.Signature, Namespace: "file"})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_WRONG_PURPOSE, verification.Failure)
+
+	verification, err = client.VerifySSH(ctx, &pb.VerifySSHRequest{Data: data[1:], Signature: signature.Signature, Namespace: "git"})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_MISMATCH, verification.Failure)
+	assert.NotEmpty(t, verification.Detail)
+
+	_, err = client.SignSSH(ctx, &pb.SignSSHRequest{Data: data})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A signature of a key the keyring does not hold does not verify.
+	otherClient, otherCloser := serveKeyring(t, ctx, NewKeyring(newTestKey(t)))
+	defer otherCloser()
+
+	verification, err = otherClient.VerifySSH(ctx, &pb.VerifySSHRequest{Data: data, Signature: signature.Signature, Namespace: "git"})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+
+	mldsaClient, mldsaCloser := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, AlgorithmMLDSA65)))
+	defer mldsaCloser()
+
+	_, err = mldsaClient.SignSSH(ctx, &pb.SignSSHRequest{Data: data, Namespace: "git"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	publicKey, err = mldsaClient.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, publicKey.Ssh)
+}
+
+func TestGrpcDocSignServer_Minisign(t *testing.T) {
+	t.Parallel()
+
+	active, retired := newTestKey(t), newTestKey(t)
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, NewKeyring(active, retired))
+	defer closer()
+
+	data := randData(t, 1024)
+	signature, err := client.SignMinisign(ctx, &pb.SignMinisignRequest{Data: data, TrustedComment: "file:data"})
+	assert.NoError(t, err)
+	assert.Equal(t, active.ID, signature.KeyId)
+
+	verification, err := client.VerifyMinisign(ctx, &pb.VerifyMinisignRequest{Data: data, Signature: signature.Signature})
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+	assert.Equal(t, active.ID, verification.KeyId)
+	assert.Equal(t, "file:data", verification.TrustedComment)
+
+	verification, err = client.VerifyMinisign(ctx, &pb.VerifyMinisignRequest{Data: data[1:], Signature: signature.Signature})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_MISMATCH, verification.Failure)
+
+	verification, err = client.VerifyMinisign(ctx, &pb.VerifyMinisignRequest{Data: data, Signature: "signature"})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_BAD_ENCODING, verification.Failure)
+
+	_, err = client.SignMinisign(ctx, &pb.SignMinisignRequest{Data: data, UntrustedComment: "two\nlines"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	publicKey, err := client.GetMinisignPublicKey(ctx, &pb.GetMinisignPublicKeyRequest{KeyId: retired.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, retired.ID, publicKey.KeyId)
+	assert.Contains(t, publicKey.Key, "minisign public key "+publicKey.KeyNumber)
+
+	_, err = client.GetMinisignPublicKey(ctx, &pb.GetMinisignPublicKeyRequest{KeyId: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	ecdsaClient, ecdsaCloser := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256)))
+	defer ecdsaCloser()
+
+	_, err = ecdsaClient.SignMinisign(ctx, &pb.SignMinisignRequest{Data: data})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = ecdsaClient.GetMinisignPublicKey(ctx, &pb.GetMinisignPublicKeyRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcDocSignServer_DSSE(t *testing.T) {
+	t.Parallel()
+
+	active, cosigner, retired := newTestKey(t), newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256), newTestKey(t)
+	keyring := NewKeyring(active, retired)
+	keyring.SetCosigners(cosigner)
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, keyring)
+	defer closer()
+
+	payload := []byte(`{"_type":"https://in-toto.io/Statement/v1"}`)
+
+	envelope, err := client.SignDSSE(ctx, &pb.SignDSSERequest{PayloadType: InTotoPayloadType, Payload: payload})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{active.ID}, envelope.KeyIds)
+
+	multiSigned, err := client.SignDSSE(ctx, &pb.SignDSSERequest{
+		PayloadType: InTotoPayloadType,
+		Payload:     payload,
+		KeyIds:      []string{active.ID, cosigner.ID},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{active.ID, cosigner.ID}, multiSigned.KeyIds)
+
+	verification, err := client.VerifyDSSE(ctx, &pb.VerifyDSSERequest{Envelope: multiSigned.Envelope})
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+	assert.Equal(t, []string{active.ID, cosigner.ID}, verification.KeyIds)
+	assert.Equal(t, InTotoPayloadType, verification.PayloadType)
+	assert.Equal(t, payload, verification.Payload)
+
+	verification, err = client.VerifyDSSE(ctx, &pb.VerifyDSSERequest{Envelope: []byte("{}")})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_BAD_ENCODING, verification.Failure)
+
+	_, err = client.SignDSSE(ctx, &pb.SignDSSERequest{Payload: payload})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.SignDSSE(ctx, &pb.SignDSSERequest{PayloadType: InTotoPayloadType, KeyIds: []string{retired.ID}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcDocSignServer_CertificateAuthority(t *testing.T) {
+	t.Parallel()
+
+	issuer := newTestCAKey(t, AlgorithmECDSAP256SHA256, -1, 24*time.Hour)
+	ca, err := NewCertificateAuthority(issuer, DefaultCertificateProfile, NewSerialRegistry())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256)), WithCertificateAuthority(ca))
+	defer closer()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(issuer.Certificates[0])
+
+	csr := newTestCertificateRequest(t, &x509.CertificateRequest{DNSNames: []string{"service.example.com"}})
+	issued, err := client.SignCSR(ctx, &pb.SignCSRRequest{Csr: csr})
+	assert.NoError(t, err)
+	assert.Equal(t, issuer.ID, issued.KeyId)
+
+	certificate, err := x509.ParseCertificate(issued.Certificate)
+	assert.NoError(t, err)
+	assert.Equal(t, certificate.SerialNumber.Text(16), issued.SerialNumber)
+	assert.Equal(t, certificate.NotAfter, issued.NotAfter.AsTime())
+	_, err = certificate.Verify(x509.VerifyOptions{Roots: roots, DNSName: "service.example.com"})
+	assert.NoError(t, err)
+
+	subjectKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	publicKey, err := x509.MarshalPKIXPublicKey(&subjectKey.PublicKey)
+	assert.NoError(t, err)
+
+	issued, err = client.IssueCertificate(ctx, &pb.IssueCertificateRequest{
+		PublicKey: publicKey,
+		Template: &pb.CertificateTemplate{
+			CommonName:  "client",
+			IpAddresses: []string{"192.0.2.1"},
+			Uris:        []string{"spiffe://example.com/client"},
+		},
+	})
+	assert.NoError(t, err)
+
+	// The PEM chain ends with the CA certificate.
+	block, rest := pem.Decode([]byte(issued.Pem))
+	assert.Equal(t, issued.Certificate, block.Bytes)
+	block, _ = pem.Decode(rest)
+	assert.Equal(t, issuer.Certificates[0].Raw, block.Bytes)
+
+	certificate, err = x509.ParseCertificate(issued.Certificate)
+	assert.NoError(t, err)
+	assert.Equal(t, "client", certificate.Subject.CommonName)
+	assert.Equal(t, "spiffe://example.com/client", certificate.URIs[0].String())
+
+	tests := []struct {
+		name string
+		req  *pb.IssueCertificateRequest
+	}{
+		{name: "Case #1", req: &pb.IssueCertificateRequest{PublicKey: publicKey}},
+		{name: "Case #2", req: &pb.IssueCertificateRequest{PublicKey: publicKey[1:], Template: &pb.CertificateTemplate{CommonName: "client"}}},
+		{name: "Case #3", req: &pb.IssueCertificateRequest{PublicKey: publicKey, Template: &pb.CertificateTemplate{IpAddresses: []string{"192.0.2"}}}},
+		{name: "Case #4", req: &pb.IssueCertificateRequest{PublicKey: publicKey, Template: &pb.CertificateTemplate{Uris: []string{"client"}}}},
 	}
 
-	for scenario, tt := range tests {
-		t.Run(scenario, func(t *testing.T) {
-			sign, err := client.Sign(ctx, tt)
-			assert.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.IssueCertificate(ctx, tt.req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
 
-			verification, err := client.Verify(ctx, &pb.VerifyRequest{Sign: sign, Doc: tt})
-			assert.NoError(t, err)
-			assert.True(t, verification.IsOk)
+	_, err = client.SignCSR(ctx, &pb.SignCSRRequest{Csr: csr[1:]})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Documents are signed with another key, a signature of a TBSCertificate is no certificate signature.
+	sign, err := client.Sign(ctx, &pb.Document{Data: certificate.RawTBSCertificate})
+	assert.NoError(t, err)
+	assert.NotEqual(t, issuer.ID, sign.KeyId)
+	assert.Error(t, issuer.Certificates[0].CheckSignature(certificate.SignatureAlgorithm, certificate.RawTBSCertificate, sign.Sign))
+
+	// The CA key never signs documents.
+	_, err = NewSignServer(NewKeyring(issuer), WithCertificateAuthority(ca))
+	assert.Error(t, err)
+
+	_, err = NewSignServer(NewKeyring(newTestKey(t), issuer.retire()), WithCertificateAuthority(ca))
+	assert.Error(t, err)
+
+	// Without a CA key nothing is issued.
+	uncertified, closer := serveKeyring(t, ctx, NewKeyring(newTestKey(t)))
+	defer closer()
+
+	_, err = uncertified.SignCSR(ctx, &pb.SignCSRRequest{Csr: csr})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcDocSignServer_Timestamp(t *testing.T) {
+	t.Parallel()
+
+	key := newTestTimestampKey(t, AlgorithmEd25519)
+
+	ctx := context.Background()
+	tsa := newTestTimestampAuthority(t, key)
+	client, closer := serveKeyring(t, ctx, NewKeyring(newTestKey(t)), WithTimestampAuthority(tsa))
+	defer closer()
+
+	data := randData(t, 100)
+	query := newTestTimestampQuery(t, crypto.SHA256, data, nil)
+
+	response, err := client.Timestamp(ctx, &pb.TimestampRequest{Query: query})
+	assert.NoError(t, err)
+	assert.True(t, response.Granted)
+	assert.Equal(t, key.ID, response.KeyId)
+	assert.Equal(t, _testTimestampPolicy.String(), response.Policy)
+
+	next, err := client.Timestamp(ctx, &pb.TimestampRequest{Query: query})
+	assert.NoError(t, err)
+	assert.Greater(t, len(next.SerialNumber), 0)
+	assert.NotEqual(t, response.SerialNumber, next.SerialNumber)
+
+	for _, token := range [][]byte{response.Token, response.Response} {
+		verification, err := client.VerifyTimestamp(ctx, &pb.VerifyTimestampRequest{Token: token, Data: data})
+		assert.NoError(t, err)
+		assert.True(t, verification.IsOk)
+		assert.Equal(t, key.ID, verification.KeyId)
+		assert.Equal(t, response.SerialNumber, verification.SerialNumber)
+		assert.Equal(t, response.GenTime.AsTime(), verification.GenTime.AsTime())
+		assert.Equal(t, "5eed", verification.Nonce)
+	}
+
+	verification, err := client.VerifyTimestamp(ctx, &pb.VerifyTimestampRequest{Token: response.Token, Data: data[1:]})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_MISMATCH, verification.Failure)
+	assert.NotEmpty(t, verification.Detail)
+
+	verification, err = client.VerifyTimestamp(ctx, &pb.VerifyTimestampRequest{Token: response.Token[1:], Data: data})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+	assert.Equal(t, pb.VerifyFailure_VERIFY_FAILURE_BAD_ENCODING, verification.Failure)
+
+	rejected, err := client.Timestamp(ctx, &pb.TimestampRequest{Query: query[1:]})
+	assert.NoError(t, err)
+	assert.False(t, rejected.Granted)
+	assert.NotEmpty(t, rejected.Failure)
+	assert.NotEmpty(t, rejected.Response)
+	assert.Empty(t, rejected.Token)
+
+	// Documents are signed with another key, their signatures are no token signatures.
+	sign, err := client.Sign(ctx, &pb.Document{Data: data})
+	assert.NoError(t, err)
+	assert.NotEqual(t, key.ID, sign.KeyId)
+	assert.Error(t, key.Certificates[0].CheckSignature(x509.PureEd25519, data, sign.Sign))
+
+	// The TSA key never signs documents.
+	_, err = NewSignServer(NewKeyring(key), WithTimestampAuthority(tsa))
+	assert.Error(t, err)
+
+	_, err = NewSignServer(NewKeyring(newTestKey(t), key.retire()), WithTimestampAuthority(tsa))
+	assert.Error(t, err)
+
+	// Without a TSA key the TSA is off.
+	unconfigured, closer := serveKeyring(t, ctx, NewKeyring(key))
+	defer closer()
+
+	_, err = unconfigured.Timestamp(ctx, &pb.TimestampRequest{Query: query})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGrpcDocSignServer_HTTPMessage(t *testing.T) {
+	t.Parallel()
+
+	key := newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256)
+
+	ctx := context.Background()
+	client, closer := serveKeyring(t, ctx, NewKeyring(key))
+	defer closer()
+
+	message := &pb.HTTPMessage{
+		Method:    http.MethodPost,
+		TargetUri: "https://example.com/foo?param=Value&Pet=dog",
+		Fields:    []*pb.HTTPField{{Name: "Content-Type", Value: "application/json"}},
+		Content:   []byte(`{"hello": "world"}`),
+	}
+
+	signature, err := client.SignHTTPMessage(ctx, &pb.SignHTTPMessageRequest{
+		Message:    message,
+		Components: []string{"@method", "@authority", "@path", "content-type", "content-digest"},
+		Label:      "docsign",
+		Expires:    timestamppb.New(time.Now().Add(time.Hour)),
+		Tag:        "app-123",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, key.ID, signature.KeyId)
+	assert.Equal(t, ContentDigest(message.Content), signature.ContentDigest)
+	assert.True(t, strings.HasPrefix(signature.SignatureInput, `docsign=("@method" "@authority" "@path" "content-type" "content-digest");created=`))
+	assert.True(t, strings.HasSuffix(signature.SignatureBase, `;alg="ecdsa-p256-sha256";tag="app-123"`))
+
+	message.Fields = append(message.Fields,
+		&pb.HTTPField{Name: "Content-Digest", Value: signature.ContentDigest},
+		&pb.HTTPField{Name: "Signature-Input", Value: signature.SignatureInput},
+		&pb.HTTPField{Name: "Signature", Value: signature.Signature})
+
+	verification, err := client.VerifyHTTPMessage(ctx, &pb.VerifyHTTPMessageRequest{Message: message, RequiredComponents: []string{"content-digest"}})
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+	assert.Equal(t, key.ID, verification.KeyId)
+	assert.Equal(t, "docsign", verification.Label)
+	assert.Equal(t, "app-123", verification.Tag)
+	assert.NotNil(t, verification.Created)
+	assert.NotNil(t, verification.Expires)
+
+	verification, err = client.VerifyHTTPMessage(ctx, &pb.VerifyHTTPMessageRequest{Message: message, RequiredComponents: []string{"@target-uri"}})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+
+	// Empty content is unknown unless has_content says the body is empty.
+	message.Content = nil
+	verification, err = client.VerifyHTTPMessage(ctx, &pb.VerifyHTTPMessageRequest{Message: message})
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+
+	message.HasContent = true
+	verification, err = client.VerifyHTTPMessage(ctx, &pb.VerifyHTTPMessageRequest{Message: message})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+
+	message.Content = []byte(`{"hello": "there"}`)
+	verification, err = client.VerifyHTTPMessage(ctx, &pb.VerifyHTTPMessageRequest{Message: message})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+
+	tests := []struct {
+		name string
+		req  *pb.SignHTTPMessageRequest
+		code codes.Code
+	}{
+		{name: "Case #1", req: &pb.SignHTTPMessageRequest{Message: message, Components: []string{"@status"}}, code: codes.InvalidArgument},
+		{name: "Case #2", req: &pb.SignHTTPMessageRequest{Message: message, Components: []string{"x-missing"}}, code: codes.InvalidArgument},
+		{name: "Case #3", req: &pb.SignHTTPMessageRequest{Message: &pb.HTTPMessage{TargetUri: "/foo"}}, code: codes.InvalidArgument},
+		{name: "Case #4", req: &pb.SignHTTPMessageRequest{Components: []string{"@method"}}, code: codes.InvalidArgument},
+		{name: "Case #5", req: &pb.SignHTTPMessageRequest{Message: message, Label: "Sig"}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.SignHTTPMessage(ctx, tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
 		})
 	}
+
+	mldsa, closer := serveKeyring(t, ctx, NewKeyring(newTestAlgorithmKey(t, AlgorithmMLDSA44)))
+	defer closer()
+
+	_, err = mldsa.SignHTTPMessage(ctx, &pb.SignHTTPMessageRequest{Message: message, Components: []string{"@method"}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
 
 func TestGrpcDocSignServer_SignBatch(t *testing.T) {
@@ -141,7 +1322,7 @@ func TestGrpcDocSignServer_SignBatch(t *testing.T) {
 			}
 
 			for i, doc := range tt.docs.Doc {
-				request.Docs[i] = &pb.VerifyRequest{Doc: &pb.Document{Data: doc}, Sign: &pb.DocSign{Sign: signs.Sign[i]}}
+				request.Docs[i] = &pb.VerifyRequest{Doc: &pb.Document{Data: doc}, Sign: &pb.DocSign{Sign: signs.Sign[i], KeyId: signs.KeyId}}
 			}
 
 			verification, err := client.VerifyBatch(ctx, request)
@@ -155,6 +1336,65 @@ func TestGrpcDocSignServer_SignBatch(t *testing.T) {
 	}
 }
 
+func TestGrpcDocSignServer_SignMerkleBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := newTestKey(t)
+	client, closer := serveKeyring(t, ctx, NewKeyring(key))
+	defer closer()
+
+	docs := [][]byte{randData(t, 17), randData(t, 1024), randData(t, 17), randData(t, 100), randData(t, 5)}
+	signs, err := client.SignBatch(ctx, &pb.DocumentBatch{Doc: docs, MerkleTree: true})
+	assert.NoError(t, err)
+	assert.Empty(t, signs.Sign)
+	assert.Equal(t, key.ID, signs.KeyId)
+	assert.Len(t, signs.Proofs, len(docs))
+
+	for i, doc := range docs {
+		proof := signs.Proofs[i]
+		assert.Equal(t, uint64(i), proof.LeafIndex)
+		assert.Equal(t, uint64(len(docs)), proof.TreeHead.TreeSize)
+		assert.Equal(t, key.ID, proof.TreeHead.KeyId)
+
+		verification, err := client.VerifyInclusion(ctx, &pb.VerifyInclusionRequest{Doc: &pb.Document{Data: doc}, Proof: proof})
+		assert.NoError(t, err)
+		assert.True(t, verification.IsOk)
+
+		verification, err = client.VerifyInclusion(ctx, &pb.VerifyInclusionRequest{Doc: &pb.Document{Data: docs[(i+1)%len(docs)]}, Proof: proof})
+		assert.NoError(t, err)
+		assert.False(t, verification.IsOk)
+	}
+
+	// The tree head signature is a signature of its encoding.
+	head := signs.Proofs[0].TreeHead
+	assert.True(t, key.Verify((&MerkleTreeHead{TreeSize: head.TreeSize, RootHash: head.RootHash}).Encode(), head.Sign))
+
+	// A tree head encoding signed as a raw document would prove the inclusion of any document.
+	forged := (&MerkleTreeHead{TreeSize: 1, RootHash: MerkleLeafHash(docs[0])}).Encode()
+	_, err = client.Sign(ctx, &pb.Document{Data: forged})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	rejected, err := client.SignBatch(ctx, &pb.DocumentBatch{Doc: [][]byte{forged}})
+	assert.NoError(t, err)
+	if assert.Len(t, rejected.GetResults(), 1) {
+		assert.Equal(t, int32(codes.InvalidArgument), rejected.Results[0].GetError().GetCode())
+	}
+
+	for _, req := range []*pb.VerifyInclusionRequest{
+		{Doc: &pb.Document{Data: docs[0]}},
+		{Proof: signs.Proofs[0]},
+		{Doc: &pb.Document{Data: docs[0]}, Proof: &pb.InclusionProof{}},
+	} {
+		verification, err := client.VerifyInclusion(ctx, req)
+		assert.NoError(t, err)
+		assert.False(t, verification.IsOk)
+	}
+
+	_, err = client.SignBatch(ctx, &pb.DocumentBatch{MerkleTree: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func TestGrpcDocSignServer_SignStream(t *testing.T) {
 	t.Parallel()
 
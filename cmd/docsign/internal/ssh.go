@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/r4start/sign-service/pkg/sshsig"
+	"golang.org/x/crypto/ssh"
+)
+
+var ErrNoSSHAlgorithm = errors.New("no SSH algorithm")
+
+// SSHPublicKey returns the OpenSSH form of the public key of key. Ed25519, ECDSA and RSA keys are supported.
+func SSHPublicKey(key *Key) (ssh.PublicKey, error) {
+	publicKey, err := ssh.NewPublicKey(key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoSSHAlgorithm, key.Algorithm)
+	}
+	return publicKey, nil
+}
+
+// SignSSH makes an SSHSIG signature of data within namespace, as `ssh-keygen -Y sign -n <namespace>` does. RSA
+// keys sign with rsa-sha2-512 whatever their configured algorithm is.
+func SignSSH(key *Key, namespace string, data []byte) (*sshsig.Signature, error) {
+	if _, err := SSHPublicKey(key); err != nil {
+		return nil, err
+	}
+
+	if key.Signer == nil {
+		return nil, fmt.Errorf("key %s is %s", key.ID, key.Status)
+	}
+
+	signer, err := ssh.NewSignerFromSigner(key.Signer)
+	if err != nil {
+		return nil, err
+	}
+	return sshsig.Sign(signer, namespace, data)
+}
+
+// VerifySSH checks an armored SSHSIG signature of data within namespace and returns the key of the keyring which
+// made it.
+func VerifySSH(keyring *Keyring, namespace string, data, armored []byte) (*Key, error) {
+	signature, err := sshsig.ParseArmored(armored)
+	if err != nil {
+		return nil, err
+	}
+
+	cryptoKey, ok := signature.PublicKey.(ssh.CryptoPublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoSSHAlgorithm, signature.PublicKey.Type())
+	}
+
+	// An empty id refers to the active key, so a key with no id is never looked up.
+	id := KeyID(cryptoKey.CryptoPublicKey())
+	key, ok := keyring.Lookup(id)
+	if id == "" || !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKey, id)
+	}
+
+	if err := signature.Verify(namespace, data); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// authorizedKey returns publicKey as an authorized_keys line without the trailing newline.
+func authorizedKey(publicKey ssh.PublicKey) string {
+	return strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(publicKey)), "\n")
+}
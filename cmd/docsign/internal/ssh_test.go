@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignSSH(t *testing.T) {
+	t.Parallel()
+
+	data := randData(t, 1000)
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			key, retired := newTestAlgorithmKey(t, algorithm), newTestAlgorithmKey(t, algorithm)
+			keyring := NewKeyring(key, retired)
+
+			signature, err := SignSSH(key, "file", data)
+			assert.NoError(t, err)
+
+			signer, err := VerifySSH(keyring, "file", data, signature.Armor())
+			assert.NoError(t, err)
+			assert.Equal(t, key.ID, signer.ID)
+
+			_, err = VerifySSH(keyring, "git", data, signature.Armor())
+			assert.Error(t, err)
+
+			_, err = VerifySSH(keyring, "file", data[1:], signature.Armor())
+			assert.Error(t, err)
+
+			_, err = VerifySSH(NewKeyring(retired), "file", data, signature.Armor())
+			assert.Error(t, err)
+
+			_, err = SignSSH(retired.retire(), "file", data)
+			assert.Error(t, err)
+		})
+	}
+
+	for _, algorithm := range []Algorithm{AlgorithmMLDSA44, AlgorithmEd25519MLDSA65} {
+		_, err := SignSSH(newTestAlgorithmKey(t, algorithm), "file", data)
+		assert.ErrorIs(t, err, ErrNoSSHAlgorithm)
+	}
+}
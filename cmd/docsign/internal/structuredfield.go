@@ -0,0 +1,389 @@
+package internal
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The subset of RFC 8941 structured field values HTTP message signatures use: dictionaries whose members are inner
+// lists or items with parameters. Decimals are not supported.
+
+var errMalformedStructuredField = errors.New("malformed structured field")
+
+// sfToken is a token item, strings are Go strings.
+type sfToken string
+
+type sfParameter struct {
+	Key   string
+	Value interface{}
+}
+
+// sfItem is a bare item: int64, string, sfToken, []byte or bool.
+type sfItem struct {
+	Value      interface{}
+	Parameters []sfParameter
+}
+
+// sfMember is a dictionary member, either an item or an inner list of items.
+type sfMember struct {
+	Key string
+	sfItem
+	InnerList []sfItem
+	IsList    bool
+}
+
+// parameter returns the value of the parameter key.
+func (i sfItem) parameter(key string) (interface{}, bool) {
+	for _, parameter := range i.Parameters {
+		if parameter.Key == key {
+			return parameter.Value, true
+		}
+	}
+	return nil, false
+}
+
+func parseSFDictionary(input string) ([]sfMember, error) {
+	p := &sfParser{input: input}
+	p.skipSP()
+
+	var members []sfMember
+	for !p.done() {
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+
+		member := sfMember{Key: key, sfItem: sfItem{Value: true}}
+		if p.peek() == '=' {
+			p.pos++
+			if p.peek() == '(' {
+				member.IsList = true
+				if member.InnerList, err = p.innerList(); err != nil {
+					return nil, err
+				}
+				member.Value = nil
+			} else if member.Value, err = p.bareItem(); err != nil {
+				return nil, err
+			}
+		}
+
+		if member.Parameters, err = p.parameters(); err != nil {
+			return nil, err
+		}
+
+		// A later member overrides an earlier one of the same key.
+		for i := range members {
+			if members[i].Key == key {
+				members = append(members[:i], members[i+1:]...)
+				break
+			}
+		}
+		members = append(members, member)
+
+		p.skipOWS()
+		if p.done() {
+			break
+		}
+
+		if p.peek() != ',' {
+			return nil, p.errorf("expected ','")
+		}
+		p.pos++
+		p.skipOWS()
+
+		if p.done() {
+			return nil, p.errorf("trailing ','")
+		}
+	}
+	return members, nil
+}
+
+type sfParser struct {
+	input string
+	pos   int
+}
+
+func (p *sfParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *sfParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+func (p *sfParser) skipSP() {
+	for p.peek() == ' ' {
+		p.pos++
+	}
+}
+
+func (p *sfParser) skipOWS() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.pos++
+	}
+}
+
+func (p *sfParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s at %d", errMalformedStructuredField, fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *sfParser) innerList() ([]sfItem, error) {
+	p.pos++ // (
+
+	var items []sfItem
+	for {
+		p.skipSP()
+		if p.peek() == ')' {
+			p.pos++
+			return items, nil
+		}
+
+		value, err := p.bareItem()
+		if err != nil {
+			return nil, err
+		}
+
+		parameters, err := p.parameters()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, sfItem{Value: value, Parameters: parameters})
+
+		if c := p.peek(); c != ' ' && c != ')' {
+			return nil, p.errorf("expected ' ' or ')'")
+		}
+	}
+}
+
+func (p *sfParser) parameters() ([]sfParameter, error) {
+	var parameters []sfParameter
+	for p.peek() == ';' {
+		p.pos++
+		p.skipSP()
+
+		key, err := p.key()
+		if err != nil {
+			return nil, err
+		}
+
+		var value interface{} = true
+		if p.peek() == '=' {
+			p.pos++
+			if value, err = p.bareItem(); err != nil {
+				return nil, err
+			}
+		}
+
+		parameter := sfParameter{Key: key, Value: value}
+		replaced := false
+		for i := range parameters {
+			if parameters[i].Key == key {
+				parameters[i], replaced = parameter, true
+			}
+		}
+		if !replaced {
+			parameters = append(parameters, parameter)
+		}
+	}
+	return parameters, nil
+}
+
+func (p *sfParser) key() (string, error) {
+	start := p.pos
+	if c := p.peek(); !(c >= 'a' && c <= 'z') && c != '*' {
+		return "", p.errorf("expected a key")
+	}
+
+	for c := p.peek(); (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || strings.IndexByte("_-.*", c) >= 0; c = p.peek() {
+		p.pos++
+	}
+	return p.input[start:p.pos], nil
+}
+
+func (p *sfParser) bareItem() (interface{}, error) {
+	switch c := p.peek(); {
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.integer()
+	case c == '"':
+		return p.string()
+	case c == ':':
+		return p.byteSequence()
+	case c == '?':
+		return p.boolean()
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '*':
+		return p.token(), nil
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+func (p *sfParser) integer() (int64, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+
+	for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+		p.pos++
+	}
+
+	if p.peek() == '.' {
+		return 0, p.errorf("decimals are not supported")
+	}
+
+	digits := strings.TrimPrefix(p.input[start:p.pos], "-")
+	if digits == "" || len(digits) > 15 {
+		return 0, p.errorf("invalid integer")
+	}
+	return strconv.ParseInt(p.input[start:p.pos], 10, 64)
+}
+
+func (p *sfParser) string() (string, error) {
+	p.pos++ // "
+
+	var value strings.Builder
+	for !p.done() {
+		c := p.input[p.pos]
+		p.pos++
+		switch {
+		case c == '\\':
+			if next := p.peek(); next != '"' && next != '\\' {
+				return "", p.errorf("invalid escape")
+			}
+			value.WriteByte(p.input[p.pos])
+			p.pos++
+		case c == '"':
+			return value.String(), nil
+		case c < 0x20 || c > 0x7e:
+			return "", p.errorf("invalid string character")
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *sfParser) byteSequence() ([]byte, error) {
+	p.pos++ // :
+
+	end := strings.IndexByte(p.input[p.pos:], ':')
+	if end < 0 {
+		return nil, p.errorf("unterminated byte sequence")
+	}
+
+	encoded := p.input[p.pos : p.pos+end]
+	p.pos += end + 1
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, p.errorf("invalid byte sequence")
+	}
+	return decoded, nil
+}
+
+func (p *sfParser) boolean() (bool, error) {
+	p.pos++ // ?
+
+	switch p.peek() {
+	case '1':
+		p.pos++
+		return true, nil
+	case '0':
+		p.pos++
+		return false, nil
+	default:
+		return false, p.errorf("invalid boolean")
+	}
+}
+
+func (p *sfParser) token() sfToken {
+	start := p.pos
+	// Tokens are visible ASCII characters other than the delimiters of RFC 9110, with ':' and '/' allowed.
+	for c := p.peek(); c > 0x20 && c < 0x7f && strings.IndexByte("\"(),;<=>?@[\\]{}", c) < 0; c = p.peek() {
+		p.pos++
+	}
+	return sfToken(p.input[start:p.pos])
+}
+
+// serializeSFInnerList serializes an inner list with parameters.
+func serializeSFInnerList(items []sfItem, parameters []sfParameter) (string, error) {
+	var serialized strings.Builder
+	serialized.WriteByte('(')
+	for i, item := range items {
+		if i > 0 {
+			serialized.WriteByte(' ')
+		}
+
+		if err := serializeSFItem(&serialized, item.Value, item.Parameters); err != nil {
+			return "", err
+		}
+	}
+	serialized.WriteByte(')')
+
+	if err := serializeSFParameters(&serialized, parameters); err != nil {
+		return "", err
+	}
+	return serialized.String(), nil
+}
+
+func serializeSFItem(serialized *strings.Builder, value interface{}, parameters []sfParameter) error {
+	if err := serializeSFBareItem(serialized, value); err != nil {
+		return err
+	}
+	return serializeSFParameters(serialized, parameters)
+}
+
+func serializeSFParameters(serialized *strings.Builder, parameters []sfParameter) error {
+	for _, parameter := range parameters {
+		serialized.WriteString(";" + parameter.Key)
+		if value, ok := parameter.Value.(bool); ok && value {
+			continue
+		}
+
+		serialized.WriteByte('=')
+		if err := serializeSFBareItem(serialized, parameter.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func serializeSFBareItem(serialized *strings.Builder, value interface{}) error {
+	switch value := value.(type) {
+	case int64:
+		serialized.WriteString(strconv.FormatInt(value, 10))
+	case string:
+		serialized.WriteByte('"')
+		for i := 0; i < len(value); i++ {
+			c := value[i]
+			if c < 0x20 || c > 0x7e {
+				return fmt.Errorf("%w: invalid string character %q", errMalformedStructuredField, c)
+			}
+
+			if c == '"' || c == '\\' {
+				serialized.WriteByte('\\')
+			}
+			serialized.WriteByte(c)
+		}
+		serialized.WriteByte('"')
+	case sfToken:
+		serialized.WriteString(string(value))
+	case []byte:
+		serialized.WriteString(":" + base64.StdEncoding.EncodeToString(value) + ":")
+	case bool:
+		if value {
+			serialized.WriteString("?1")
+		} else {
+			serialized.WriteString("?0")
+		}
+	default:
+		return fmt.Errorf("%w: unsupported value %T", errMalformedStructuredField, value)
+	}
+	return nil
+}
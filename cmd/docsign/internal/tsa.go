@@ -0,0 +1,586 @@
+package internal
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"mime"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+const (
+	// TimestampPath serves RFC 3161 time-stamp queries over HTTP.
+	TimestampPath = "/v1/timestamp"
+
+	TimestampQueryContentType = "application/timestamp-query"
+	TimestampReplyContentType = "application/timestamp-reply"
+
+	// Queries are a message imprint, a policy and a nonce.
+	_timestampQueryMaxSize = 16 * 1024
+)
+
+var (
+	_oidTSTInfo              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	_oidSigningCertificateV2 = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 2, 47}
+	_oidExtKeyUsage          = asn1.ObjectIdentifier{2, 5, 29, 37}
+)
+
+// PKIStatus values of a TimeStampResp.
+const (
+	_pkiStatusGranted   = 0
+	_pkiStatusRejection = 2
+)
+
+// PKIFailureInfo bits of a rejected TimeStampResp (RFC 3161 2.4.2).
+const (
+	TimestampBadAlgorithm        = 0
+	TimestampBadRequest          = 2
+	TimestampBadDataFormat       = 5
+	TimestampUnacceptedPolicy    = 15
+	TimestampUnacceptedExtension = 16
+)
+
+var (
+	ErrNoTimestampPolicy      = errors.New("no TSA policy")
+	ErrNoTimestampCertificate = errors.New("no TSA certificate")
+	ErrMalformedTimestamp     = errors.New("malformed time-stamp token")
+)
+
+// TimestampFailure rejects a time-stamp query. Info is the PKIFailureInfo bit of the response.
+type TimestampFailure struct {
+	Info   int
+	Reason string
+}
+
+func (f *TimestampFailure) Error() string {
+	return f.Reason
+}
+
+type tsaMessageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type tsaRequest struct {
+	Version        int
+	MessageImprint tsaMessageImprint
+	ReqPolicy      asn1.ObjectIdentifier `asn1:"optional"`
+	Nonce          *big.Int              `asn1:"optional"`
+	CertReq        bool                  `asn1:"optional,default:false"`
+	Extensions     asn1.RawValue         `asn1:"optional,tag:0"`
+}
+
+type tsaStatusInfo struct {
+	Status int
+	// StatusString is a sequence of UTF8String.
+	StatusString []asn1.RawValue `asn1:"optional"`
+	FailInfo     asn1.BitString  `asn1:"optional"`
+}
+
+type tsaResponse struct {
+	Status         tsaStatusInfo
+	TimeStampToken asn1.RawValue `asn1:"optional"`
+}
+
+type tsaAccuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint tsaMessageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time     `asn1:"generalized"`
+	Accuracy       tsaAccuracy   `asn1:"optional"`
+	Ordering       bool          `asn1:"optional,default:false"`
+	Nonce          *big.Int      `asn1:"optional"`
+	TSA            asn1.RawValue `asn1:"optional,tag:0"`
+	Extensions     asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+// essSigningCertificateV2 identifies the TSA certificate by its SHA-256 digest, the default hash algorithm
+// (RFC 5816).
+type essSigningCertificateV2 struct {
+	Certs []essCertIDv2
+}
+
+type essCertIDv2 struct {
+	CertHash []byte
+}
+
+// TimestampPolicy is what the TSA puts into every token.
+type TimestampPolicy struct {
+	// Policies are the TSA policies a query may ask for, the first one is used when it asks for none.
+	Policies []asn1.ObjectIdentifier
+	// Accuracy of the time of tokens, none when zero.
+	Accuracy time.Duration
+}
+
+// ParseTimestampPolicies parses a comma separated list of dotted policy OIDs, e.g. "1.3.6.1.4.1.4146.2.3".
+func ParseTimestampPolicies(oids string) ([]asn1.ObjectIdentifier, error) {
+	var policies []asn1.ObjectIdentifier
+	for _, name := range splitNames(oids) {
+		var policy asn1.ObjectIdentifier
+		for _, arc := range strings.Split(name, ".") {
+			n, err := strconv.Atoi(arc)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid policy OID %q", name)
+			}
+			policy = append(policy, n)
+		}
+
+		if len(policy) < 2 {
+			return nil, fmt.Errorf("invalid policy OID %q", name)
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// TimestampSerials hands out strictly increasing serial numbers, nanoseconds since the epoch unless the previous
+// number is later. A counter backed by a file writes every number to it before the token is signed, so restarts and
+// clocks set back do not repeat numbers.
+type TimestampSerials struct {
+	mu   sync.Mutex
+	last *big.Int
+	file *os.File
+}
+
+// NewTimestampSerials returns a counter which keeps the last serial number in memory only.
+func NewTimestampSerials() *TimestampSerials {
+	return &TimestampSerials{last: new(big.Int)}
+}
+
+// OpenTimestampSerials returns a counter backed by the file at path, which holds the hex encoded last serial number
+// and is created when missing.
+func OpenTimestampSerials(path string) (*TimestampSerials, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open time-stamp serials %s: %w", path, err)
+	}
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("read time-stamp serials %s: %w", path, err)
+	}
+
+	last := new(big.Int)
+	if text := strings.TrimSpace(string(content)); text != "" {
+		if _, ok := last.SetString(text, 16); !ok || last.Sign() < 0 {
+			file.Close()
+			return nil, fmt.Errorf("read time-stamp serials %s: invalid serial number %q", path, text)
+		}
+	}
+	return &TimestampSerials{last: last, file: file}, nil
+}
+
+// Next returns the next serial number.
+func (s *TimestampSerials) Next() (*big.Int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	next := big.NewInt(time.Now().UnixNano())
+	if next.Cmp(s.last) <= 0 {
+		next.Add(s.last, big.NewInt(1))
+	}
+
+	if s.file != nil {
+		if err := s.file.Truncate(0); err != nil {
+			return nil, err
+		}
+
+		if _, err := s.file.WriteAt([]byte(next.Text(16)+"\n"), 0); err != nil {
+			return nil, err
+		}
+
+		if err := s.file.Sync(); err != nil {
+			return nil, err
+		}
+	}
+
+	s.last = next
+	return new(big.Int).Set(next), nil
+}
+
+// Close closes the file of the counter.
+func (s *TimestampSerials) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	return s.file.Close()
+}
+
+// TimestampAuthority issues RFC 3161 time-stamp tokens signed by a key whose certificate has the timeStamping
+// extended key usage only. The TSA key must sign nothing else: a key which signs documents signs the signed attributes
+// of a token with a time of the caller's choice.
+type TimestampAuthority struct {
+	key     *Key
+	policy  TimestampPolicy
+	serials *TimestampSerials
+}
+
+// NewTimestampAuthority returns a TSA which issues tokens of policy with key. Without a key every query is refused.
+func NewTimestampAuthority(key *Key, policy TimestampPolicy, serials *TimestampSerials) (*TimestampAuthority, error) {
+	if policy.Accuracy < 0 {
+		return nil, fmt.Errorf("time-stamp accuracy %s is negative", policy.Accuracy)
+	}
+	return &TimestampAuthority{key: key, policy: policy, serials: serials}, nil
+}
+
+// TimestampRequest is a parsed TimeStampReq.
+type TimestampRequest struct {
+	Hash          crypto.Hash
+	HashedMessage []byte
+	// Policy is the policy the query asks for, nil when any.
+	Policy  asn1.ObjectIdentifier
+	Nonce   *big.Int
+	CertReq bool
+}
+
+// ParseTimestampRequest decodes a DER encoded TimeStampReq, e.g. of `openssl ts -query`. Errors are
+// *TimestampFailure.
+func ParseTimestampRequest(der []byte) (*TimestampRequest, error) {
+	var request tsaRequest
+	if rest, err := asn1.Unmarshal(der, &request); err != nil || len(rest) != 0 {
+		return nil, &TimestampFailure{Info: TimestampBadDataFormat, Reason: fmt.Sprintf("malformed query: %v", err)}
+	}
+
+	if request.Version != 1 {
+		return nil, &TimestampFailure{Info: TimestampBadRequest, Reason: fmt.Sprintf("query version %d", request.Version)}
+	}
+
+	if len(request.Extensions.FullBytes) != 0 {
+		return nil, &TimestampFailure{Info: TimestampUnacceptedExtension, Reason: "query extensions are not supported"}
+	}
+
+	hash, err := tsaHash(request.MessageImprint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimestampRequest{
+		Hash:          hash,
+		HashedMessage: request.MessageImprint.HashedMessage,
+		Policy:        request.ReqPolicy,
+		Nonce:         request.Nonce,
+		CertReq:       request.CertReq,
+	}, nil
+}
+
+// TimestampToken is an issued or verified time-stamp token.
+type TimestampToken struct {
+	// Token is the DER encoded ContentInfo of the SignedData holding the TSTInfo.
+	Token        []byte
+	Key          *Key
+	SerialNumber *big.Int
+	GenTime      time.Time
+	Accuracy     time.Duration
+	Policy       asn1.ObjectIdentifier
+	Nonce        *big.Int
+}
+
+// Timestamp issues a token of the message imprint of request. The token embeds the TSA certificate when the query
+// asks for it. Rejections of the query are *TimestampFailure.
+func (tsa *TimestampAuthority) Timestamp(request *TimestampRequest) (*TimestampToken, error) {
+	if len(tsa.policy.Policies) == 0 {
+		return nil, ErrNoTimestampPolicy
+	}
+
+	key := tsa.key
+	if key == nil {
+		return nil, fmt.Errorf("%w: no TSA key", ErrNoTimestampCertificate)
+	}
+
+	policy := tsa.policy.Policies[0]
+	if request.Policy != nil {
+		policy = nil
+		for _, accepted := range tsa.policy.Policies {
+			if accepted.Equal(request.Policy) {
+				policy = accepted
+			}
+		}
+
+		if policy == nil {
+			return nil, &TimestampFailure{Info: TimestampUnacceptedPolicy, Reason: fmt.Sprintf("policy %s", request.Policy)}
+		}
+	}
+
+	if err := tsaCertificate(key); err != nil {
+		return nil, err
+	}
+
+	serial, err := tsa.serials.Next()
+	if err != nil {
+		return nil, fmt.Errorf("next serial number: %w", err)
+	}
+
+	digestAlgorithm := _cmsDigestOIDs[request.Hash]
+	genTime := time.Now().UTC().Truncate(time.Second)
+	info := tstInfo{
+		Version: 1,
+		Policy:  policy,
+		MessageImprint: tsaMessageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: digestAlgorithm},
+			HashedMessage: request.HashedMessage,
+		},
+		SerialNumber: serial,
+		GenTime:      genTime,
+		Accuracy:     tsaAccuracyOf(tsa.policy.Accuracy),
+		Nonce:        request.Nonce,
+		TSA:          tsaName(key.Certificates[0]),
+	}
+
+	content, err := asn1.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates []*x509.Certificate
+	if request.CertReq {
+		certificates = key.Certificates
+	}
+
+	certificateHash := sha256.Sum256(key.Certificates[0].Raw)
+	token, err := cmsSign(key, _oidTSTInfo, content, false, certificates, []cmsSignedAttribute{
+		{_oidSigningCertificateV2, essSigningCertificateV2{Certs: []essCertIDv2{{CertHash: certificateHash[:]}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TimestampToken{
+		Token:        token,
+		Key:          key,
+		SerialNumber: serial,
+		GenTime:      genTime,
+		Accuracy:     tsa.policy.Accuracy,
+		Policy:       policy,
+		Nonce:        request.Nonce,
+	}, nil
+}
+
+// TimestampResponse returns the DER encoded TimeStampResp granting token.
+func TimestampResponse(token *TimestampToken) ([]byte, error) {
+	return asn1.Marshal(tsaResponse{
+		Status:         tsaStatusInfo{Status: _pkiStatusGranted},
+		TimeStampToken: asn1.RawValue{FullBytes: token.Token},
+	})
+}
+
+// TimestampRejection returns the DER encoded TimeStampResp rejecting a query.
+func TimestampRejection(failure *TimestampFailure) ([]byte, error) {
+	// DER drops the trailing zero bits of named bit lists, the bit of the failure is the last one.
+	failInfo := asn1.BitString{Bytes: make([]byte, failure.Info/8+1), BitLength: failure.Info + 1}
+	failInfo.Bytes[failure.Info/8] |= 0x80 >> (failure.Info % 8)
+
+	return asn1.Marshal(tsaResponse{Status: tsaStatusInfo{
+		Status:       _pkiStatusRejection,
+		StatusString: []asn1.RawValue{{Tag: asn1.TagUTF8String, Bytes: []byte(failure.Reason)}},
+		FailInfo:     failInfo,
+	}})
+}
+
+// Verify checks a time-stamp token, or a TimeStampResp granting one, of data against the TSA key, see VerifyTimestamp.
+func (tsa *TimestampAuthority) Verify(token, data []byte) (*TimestampToken, error) {
+	if tsa.key == nil {
+		return nil, fmt.Errorf("%w: no TSA key", ErrNoTimestampCertificate)
+	}
+	return VerifyTimestamp(NewKeyring(tsa.key), token, data)
+}
+
+// VerifyTimestamp checks a time-stamp token, or a TimeStampResp granting one, of data against the keys of keyring.
+// The TSA certificate is the embedded one or the certificate of the key, its chain is not validated.
+func VerifyTimestamp(keyring *Keyring, token, data []byte) (*TimestampToken, error) {
+	token, err := tsaToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	signedData, err := cmsParseSignedData(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if !signedData.EncapContentInfo.EContentType.Equal(_oidTSTInfo) {
+		return nil, fmt.Errorf("%w: content type %s", ErrMalformedTimestamp, signedData.EncapContentInfo.EContentType)
+	}
+
+	var certificates []*x509.Certificate
+	for _, key := range keyring.Keys() {
+		certificates = append(certificates, key.Certificates...)
+	}
+
+	key, attributes, err := cmsVerifySigner(keyring, signedData, signedData.EncapContentInfo.EContent, certificates)
+	if err != nil {
+		return nil, err
+	}
+
+	var signingCertificate essSigningCertificateV2
+	if err := cmsAttributeValue(attributes, _oidSigningCertificateV2, &signingCertificate); err != nil {
+		return nil, err
+	}
+
+	var info tstInfo
+	if rest, err := asn1.Unmarshal(signedData.EncapContentInfo.EContent, &info); err != nil || len(rest) != 0 {
+		return nil, fmt.Errorf("%w: TSTInfo: %v", ErrMalformedTimestamp, err)
+	}
+
+	if info.Version != 1 {
+		return nil, fmt.Errorf("%w: TSTInfo version %d", ErrMalformedTimestamp, info.Version)
+	}
+
+	hash, err := tsaHash(info.MessageImprint)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedTimestamp, err)
+	}
+
+	digest := hash.New()
+	digest.Write(data)
+	if !bytes.Equal(digest.Sum(nil), info.MessageImprint.HashedMessage) {
+		return nil, errors.New("time-stamp message imprint mismatch")
+	}
+
+	return &TimestampToken{
+		Token:        token,
+		Key:          key,
+		SerialNumber: info.SerialNumber,
+		GenTime:      info.GenTime,
+		Accuracy: time.Duration(info.Accuracy.Seconds)*time.Second +
+			time.Duration(info.Accuracy.Millis)*time.Millisecond + time.Duration(info.Accuracy.Micros)*time.Microsecond,
+		Policy: info.Policy,
+		Nonce:  info.Nonce,
+	}, nil
+}
+
+// tsaToken returns the token of a TimeStampResp or der itself when it is no TimeStampResp.
+func tsaToken(der []byte) ([]byte, error) {
+	var response tsaResponse
+	if rest, err := asn1.Unmarshal(der, &response); err != nil || len(rest) != 0 {
+		return der, nil
+	}
+
+	if response.Status.Status > 1 || len(response.TimeStampToken.FullBytes) == 0 {
+		var reasons []string
+		for _, reason := range response.Status.StatusString {
+			reasons = append(reasons, string(reason.Bytes))
+		}
+		return nil, fmt.Errorf("%w: status %d: %s", ErrMalformedTimestamp, response.Status.Status, strings.Join(reasons, ", "))
+	}
+	return response.TimeStampToken.FullBytes, nil
+}
+
+// tsaHash returns the hash of a message imprint of a supported algorithm.
+func tsaHash(imprint tsaMessageImprint) (crypto.Hash, error) {
+	for hash, oid := range _cmsDigestOIDs {
+		if !oid.Equal(imprint.HashAlgorithm.Algorithm) {
+			continue
+		}
+
+		if len(imprint.HashedMessage) != hash.Size() {
+			return 0, &TimestampFailure{Info: TimestampBadDataFormat, Reason: fmt.Sprintf("%d byte %s message imprint", len(imprint.HashedMessage), hash)}
+		}
+		return hash, nil
+	}
+	return 0, &TimestampFailure{Info: TimestampBadAlgorithm, Reason: fmt.Sprintf("hash algorithm %s", imprint.HashAlgorithm.Algorithm)}
+}
+
+// tsaCertificate checks that key has a certificate for time-stamping: RFC 3161 2.3 requires a critical extended key
+// usage extension with timeStamping and no other usage.
+func tsaCertificate(key *Key) error {
+	if len(key.Certificates) == 0 {
+		return fmt.Errorf("%w: key %s", ErrNoCertificate, key.ID)
+	}
+
+	certificate := key.Certificates[0]
+	if len(certificate.ExtKeyUsage) != 1 || certificate.ExtKeyUsage[0] != x509.ExtKeyUsageTimeStamping ||
+		len(certificate.UnknownExtKeyUsage) != 0 {
+		return fmt.Errorf("%w: certificate of key %s is not for time-stamping only", ErrNoTimestampCertificate, key.ID)
+	}
+
+	for _, extension := range certificate.Extensions {
+		if extension.Id.Equal(_oidExtKeyUsage) && !extension.Critical {
+			return fmt.Errorf("%w: extended key usage of the certificate of key %s is not critical", ErrNoTimestampCertificate, key.ID)
+		}
+	}
+
+	if time.Now().After(certificate.NotAfter) {
+		return fmt.Errorf("%w: certificate of key %s expired at %s", ErrNoTimestampCertificate, key.ID, certificate.NotAfter)
+	}
+	return nil
+}
+
+// tsaName is the subject of the TSA certificate as the directoryName of the TSTInfo tsa field.
+func tsaName(certificate *x509.Certificate) asn1.RawValue {
+	name, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 4, IsCompound: true, Bytes: certificate.RawSubject})
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: name}
+}
+
+func tsaAccuracyOf(accuracy time.Duration) tsaAccuracy {
+	return tsaAccuracy{
+		Seconds: int(accuracy / time.Second),
+		Millis:  int(accuracy % time.Second / time.Millisecond),
+		Micros:  int(accuracy % time.Millisecond / time.Microsecond),
+	}
+}
+
+// TimestampHandler answers RFC 3161 time-stamp queries over HTTP: a POST of an application/timestamp-query body is
+// passed to Timestamp and answered with its application/timestamp-reply, rejections included.
+func TimestampHandler(mux *runtime.ServeMux, client pb.SignServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/signservice.SignService/Timestamp")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != TimestampQueryContentType {
+			runtime.HTTPError(ctx, mux, outbound, w, r,
+				status.Errorf(codes.InvalidArgument, "content type %q is not %s", mediaType, TimestampQueryContentType))
+			return
+		}
+
+		query, err := io.ReadAll(http.MaxBytesReader(w, r.Body, _timestampQueryMaxSize))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "read query: %v", err))
+			return
+		}
+
+		var header metadata.MD
+		response, err := client.Timestamp(ctx, &pb.TimestampRequest{Query: query}, grpc.Header(&header))
+		ctx = runtime.NewServerMetadataContext(ctx, runtime.ServerMetadata{HeaderMD: header})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", TimestampReplyContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(response.Response)))
+		_, _ = w.Write(response.Response)
+	}
+}
@@ -0,0 +1,317 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+var _testTimestampPolicy = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 55555, 1}
+
+// newTestTimestampKey returns a key with a time-stamping certificate issued by a new CA.
+func newTestTimestampKey(t *testing.T, algorithm Algorithm, extKeyUsage ...x509.ExtKeyUsage) *Key {
+	if len(extKeyUsage) == 0 {
+		extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping}
+	}
+
+	key := newTestAlgorithmKey(t, algorithm)
+	key, err := key.WithCertificates(newTestCertificates(t, key.PublicKey, extKeyUsage...))
+	assert.NoError(t, err)
+	return key
+}
+
+func newTestTimestampAuthority(t *testing.T, key *Key) *TimestampAuthority {
+	tsa, err := NewTimestampAuthority(key, TimestampPolicy{
+		Policies: []asn1.ObjectIdentifier{_testTimestampPolicy, {1, 3, 6, 1, 4, 1, 55555, 2}},
+		Accuracy: 1500 * time.Millisecond,
+	}, NewTimestampSerials())
+	assert.NoError(t, err)
+	return tsa
+}
+
+// newTestTimestampQuery returns a DER encoded TimeStampReq of data.
+func newTestTimestampQuery(t *testing.T, hash crypto.Hash, data []byte, modify func(*tsaRequest)) []byte {
+	digest := hash.New()
+	digest.Write(data)
+
+	request := tsaRequest{
+		Version: 1,
+		MessageImprint: tsaMessageImprint{
+			HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: _cmsDigestOIDs[hash], Parameters: asn1.NullRawValue},
+			HashedMessage: digest.Sum(nil),
+		},
+		Nonce:   big.NewInt(0x5eed),
+		CertReq: true,
+	}
+	if modify != nil {
+		modify(&request)
+	}
+
+	query, err := asn1.Marshal(request)
+	assert.NoError(t, err)
+	return query
+}
+
+func TestTimestampAuthority(t *testing.T) {
+	t.Parallel()
+
+	data := randData(t, 1000)
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			key := newTestTimestampKey(t, algorithm)
+			keyring := NewKeyring(key)
+			tsa := newTestTimestampAuthority(t, key)
+
+			request, err := ParseTimestampRequest(newTestTimestampQuery(t, crypto.SHA256, data, nil))
+			assert.NoError(t, err)
+
+			token, err := tsa.Timestamp(request)
+			assert.NoError(t, err)
+			assert.Equal(t, _testTimestampPolicy, token.Policy)
+			assert.Equal(t, key.ID, token.Key.ID)
+
+			verification, err := VerifyTimestamp(keyring, token.Token, data)
+			assert.NoError(t, err)
+			assert.Equal(t, key.ID, verification.Key.ID)
+			assert.Equal(t, token.SerialNumber, verification.SerialNumber)
+			assert.True(t, token.GenTime.Equal(verification.GenTime))
+			assert.WithinDuration(t, time.Now(), verification.GenTime, time.Minute)
+			assert.Equal(t, 1500*time.Millisecond, verification.Accuracy)
+			assert.True(t, _testTimestampPolicy.Equal(verification.Policy))
+			assert.Equal(t, big.NewInt(0x5eed), verification.Nonce)
+
+			// A TimeStampResp verifies as its token.
+			response, err := TimestampResponse(token)
+			assert.NoError(t, err)
+			_, err = VerifyTimestamp(keyring, response, data)
+			assert.NoError(t, err)
+
+			_, err = VerifyTimestamp(keyring, token.Token, data[1:])
+			assert.Error(t, err)
+
+			verification, err = tsa.Verify(token.Token, data)
+			assert.NoError(t, err)
+			assert.Equal(t, key.ID, verification.Key.ID)
+
+			_, err = VerifyTimestamp(NewKeyring(newTestTimestampKey(t, algorithm)), token.Token, data)
+			assert.Error(t, err)
+
+			// Without the embedded certificate the certificate of the key identifies the signer.
+			request, err = ParseTimestampRequest(newTestTimestampQuery(t, crypto.SHA512, data, func(request *tsaRequest) {
+				request.CertReq = false
+				request.Nonce = nil
+				request.ReqPolicy = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 55555, 2}
+			}))
+			assert.NoError(t, err)
+
+			next, err := tsa.Timestamp(request)
+			assert.NoError(t, err)
+			assert.Less(t, len(next.Token), len(token.Token))
+			assert.Equal(t, 1, next.SerialNumber.Cmp(token.SerialNumber))
+
+			verification, err = VerifyTimestamp(keyring, next.Token, data)
+			assert.NoError(t, err)
+			assert.Nil(t, verification.Nonce)
+			assert.Equal(t, "1.3.6.1.4.1.55555.2", verification.Policy.String())
+		})
+	}
+}
+
+func TestTimestampAuthority_Reject(t *testing.T) {
+	t.Parallel()
+
+	data := randData(t, 100)
+	key := newTestTimestampKey(t, AlgorithmEd25519)
+	tsa := newTestTimestampAuthority(t, key)
+
+	tests := []struct {
+		name  string
+		query []byte
+		info  int
+	}{
+		{name: "Case #1", query: nil, info: TimestampBadDataFormat},
+		{name: "Case #2", query: newTestTimestampQuery(t, crypto.SHA256, data, nil)[1:], info: TimestampBadDataFormat},
+		{name: "Case #3", query: newTestTimestampQuery(t, crypto.SHA256, data, func(request *tsaRequest) {
+			request.Version = 2
+		}), info: TimestampBadRequest},
+		{name: "Case #4", query: newTestTimestampQuery(t, crypto.SHA256, data, func(request *tsaRequest) {
+			request.MessageImprint.HashAlgorithm.Algorithm = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+		}), info: TimestampBadAlgorithm},
+		{name: "Case #5", query: newTestTimestampQuery(t, crypto.SHA256, data, func(request *tsaRequest) {
+			request.MessageImprint.HashedMessage = request.MessageImprint.HashedMessage[1:]
+		}), info: TimestampBadDataFormat},
+		{name: "Case #6", query: newTestTimestampQuery(t, crypto.SHA256, data, func(request *tsaRequest) {
+			request.ReqPolicy = asn1.ObjectIdentifier{1, 2, 3}
+		}), info: TimestampUnacceptedPolicy},
+		{name: "Case #7", query: newTestTimestampQuery(t, crypto.SHA256, data, func(request *tsaRequest) {
+			request.Extensions = asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: []byte{0x30, 0x00}}
+		}), info: TimestampUnacceptedExtension},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := ParseTimestampRequest(tt.query)
+			if err == nil {
+				_, err = tsa.Timestamp(request)
+			}
+
+			var failure *TimestampFailure
+			assert.ErrorAs(t, err, &failure)
+			assert.Equal(t, tt.info, failure.Info)
+
+			rejection, err := TimestampRejection(failure)
+			assert.NoError(t, err)
+
+			var response tsaResponse
+			_, err = asn1.Unmarshal(rejection, &response)
+			assert.NoError(t, err)
+			assert.Equal(t, _pkiStatusRejection, response.Status.Status)
+			assert.Equal(t, tt.info+1, response.Status.FailInfo.BitLength)
+			assert.Equal(t, 1, response.Status.FailInfo.At(tt.info))
+
+			_, err = VerifyTimestamp(NewKeyring(key), rejection, data)
+			assert.ErrorIs(t, err, ErrMalformedTimestamp)
+		})
+	}
+
+	request, err := ParseTimestampRequest(newTestTimestampQuery(t, crypto.SHA256, data, nil))
+	assert.NoError(t, err)
+
+	_, err = newTestTimestampAuthority(t, newTestCertifiedKey(t, AlgorithmEd25519)).Timestamp(request)
+	assert.ErrorIs(t, err, ErrNoTimestampCertificate)
+
+	_, err = newTestTimestampAuthority(t, newTestTimestampKey(t, AlgorithmEd25519, x509.ExtKeyUsageTimeStamping, x509.ExtKeyUsageCodeSigning)).Timestamp(request)
+	assert.ErrorIs(t, err, ErrNoTimestampCertificate)
+
+	// tsaCertificate reads the parsed extensions, a non-critical extended key usage needs no new certificate.
+	nonCritical := newTestTimestampKey(t, AlgorithmEd25519)
+	for i, extension := range nonCritical.Certificates[0].Extensions {
+		if extension.Id.Equal(_oidExtKeyUsage) {
+			assert.True(t, extension.Critical)
+			nonCritical.Certificates[0].Extensions[i].Critical = false
+		}
+	}
+	_, err = newTestTimestampAuthority(t, nonCritical).Timestamp(request)
+	assert.ErrorIs(t, err, ErrNoTimestampCertificate)
+
+	_, err = newTestTimestampAuthority(t, newTestKey(t)).Timestamp(request)
+	assert.ErrorIs(t, err, ErrNoCertificate)
+
+	_, err = newTestTimestampAuthority(t, nil).Timestamp(request)
+	assert.ErrorIs(t, err, ErrNoTimestampCertificate)
+
+	unconfigured, err := NewTimestampAuthority(key, TimestampPolicy{}, NewTimestampSerials())
+	assert.NoError(t, err)
+	_, err = unconfigured.Timestamp(request)
+	assert.ErrorIs(t, err, ErrNoTimestampPolicy)
+}
+
+func TestTimestampSerials(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "serials")
+
+	serials, err := OpenTimestampSerials(path)
+	assert.NoError(t, err)
+
+	last := new(big.Int)
+	for i := 0; i < 100; i++ {
+		serial, err := serials.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, serial.Cmp(last))
+		last = serial
+	}
+	assert.NoError(t, serials.Close())
+
+	// Numbers keep increasing after a restart, even past the clock.
+	future := new(big.Int).Lsh(big.NewInt(1), 70)
+	assert.NoError(t, os.WriteFile(path, []byte(future.Text(16)+"\n"), 0o600))
+
+	serials, err = OpenTimestampSerials(path)
+	assert.NoError(t, err)
+	defer serials.Close()
+
+	serial, err := serials.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Add(future, big.NewInt(1)), serial)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, serial.Text(16)+"\n", string(content))
+
+	assert.NoError(t, os.WriteFile(path, []byte("serial"), 0o600))
+	_, err = OpenTimestampSerials(path)
+	assert.Error(t, err)
+}
+
+func TestParseTimestampPolicies(t *testing.T) {
+	t.Parallel()
+
+	policies, err := ParseTimestampPolicies("1.3.6.1.4.1.55555.1, 2.5")
+	assert.NoError(t, err)
+	assert.Equal(t, []asn1.ObjectIdentifier{_testTimestampPolicy, {2, 5}}, policies)
+
+	policies, err = ParseTimestampPolicies("")
+	assert.NoError(t, err)
+	assert.Empty(t, policies)
+
+	for _, oids := range []string{"1", "1.-2", "1.2.x", "1..2"} {
+		_, err := ParseTimestampPolicies(oids)
+		assert.Error(t, err)
+	}
+}
+
+func TestTimestampHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	key := newTestTimestampKey(t, AlgorithmECDSAP256SHA256)
+	client, closer := serveKeyring(t, ctx, NewKeyring(newTestKey(t)), WithTimestampAuthority(newTestTimestampAuthority(t, key)))
+	defer closer()
+
+	mux := runtime.NewServeMux()
+	assert.NoError(t, mux.HandlePath(http.MethodPost, TimestampPath, TimestampHandler(mux, client)))
+
+	post := func(contentType string, query []byte) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, TimestampPath, bytes.NewReader(query))
+		request.Header.Set("Content-Type", contentType)
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	data := randData(t, 100)
+	response := post(TimestampQueryContentType, newTestTimestampQuery(t, crypto.SHA256, data, nil))
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, TimestampReplyContentType, response.Header().Get("Content-Type"))
+
+	verification, err := VerifyTimestamp(NewKeyring(key), response.Body.Bytes(), data)
+	assert.NoError(t, err)
+	assert.Equal(t, key.ID, verification.Key.ID)
+
+	// Rejections are replies too.
+	response = post(TimestampQueryContentType, []byte("query"))
+	assert.Equal(t, http.StatusOK, response.Code)
+	_, err = VerifyTimestamp(NewKeyring(key), response.Body.Bytes(), data)
+	assert.ErrorIs(t, err, ErrMalformedTimestamp)
+
+	response = post("application/octet-stream", newTestTimestampQuery(t, crypto.SHA256, data, nil))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = post(TimestampQueryContentType, make([]byte, _timestampQueryMaxSize+1))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
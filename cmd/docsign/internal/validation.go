@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"runtime/debug"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+// ValidateRequest checks a request against the FieldRules of its fields, those of nested messages included. A
+// request which breaks them is rejected with an InvalidArgument status carrying a BadRequest with every violation.
+func ValidateRequest(request proto.Message) error {
+	violations := validateMessage(request.ProtoReflect(), "", nil)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s %s", request.ProtoReflect().Descriptor().Name(),
+		violations[0].Field, violations[0].Description))
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func validateMessage(message protoreflect.Message, path string, violations []*errdetails.BadRequest_FieldViolation) []*errdetails.BadRequest_FieldViolation {
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := path + string(field.Name())
+
+		rules, _ := proto.GetExtension(field.Options(), pb.E_Rules).(*pb.FieldRules)
+		if rules != nil {
+			if description := fieldViolation(message, field, rules); description != "" {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: name, Description: description})
+			}
+		}
+
+		// Per item rules leave the items to the service.
+		if rules.GetPerItem() || field.Kind() != protoreflect.MessageKind || field.IsMap() || !message.Has(field) {
+			continue
+		}
+
+		if field.IsList() {
+			list := message.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				violations = validateMessage(list.Get(j).Message(), fmt.Sprintf("%s[%d].", name, j), violations)
+			}
+			continue
+		}
+		violations = validateMessage(message.Get(field).Message(), name+".", violations)
+	}
+	return violations
+}
+
+// fieldViolation describes how a field breaks its rules, an empty description means it does not.
+func fieldViolation(message protoreflect.Message, field protoreflect.FieldDescriptor, rules *pb.FieldRules) string {
+	if !message.Has(field) {
+		if rules.Required {
+			return "is required"
+		}
+		return ""
+	}
+
+	if field.IsList() || field.IsMap() {
+		return ""
+	}
+
+	value := message.Get(field)
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		if rules.Len != 0 && len(value.Bytes()) != int(rules.Len) {
+			return fmt.Sprintf("must be %d bytes long", rules.Len)
+		}
+	case protoreflect.StringKind:
+		if rules.Len != 0 && len(value.String()) != int(rules.Len) {
+			return fmt.Sprintf("must be %d bytes long", rules.Len)
+		}
+	case protoreflect.EnumKind:
+		if rules.DefinedOnly && field.Enum().Values().ByNumber(value.Enum()) == nil {
+			return fmt.Sprintf("has no %s value %d", field.Enum().Name(), value.Enum())
+		}
+	}
+	return ""
+}
+
+// ValidationUnaryServerInterceptor rejects requests which break the FieldRules of service.proto.
+func ValidationUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if request, ok := req.(proto.Message); ok {
+			if err := ValidateRequest(request); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamServerInterceptor rejects every streamed request which breaks the FieldRules of service.proto, the
+// stream fails with the error of the first one.
+func ValidationStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: stream})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if request, ok := m.(proto.Message); ok {
+		return ValidateRequest(request)
+	}
+	return nil
+}
+
+// RecoverPanic turns a panic of a handler into an Internal status, the panic and its stack are logged but never
+// returned to the client.
+func RecoverPanic(ctx context.Context, p any) error {
+	method, _ := grpc.Method(ctx)
+	log.Printf("panic in %s: %v\n%s", method, p, debug.Stack())
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/r4start/sign-service/pkg/proto"
+)
+
+// serveValidated serves keyring behind the validation and recovery interceptors of main.
+func serveValidated(t *testing.T, ctx context.Context, keyring *Keyring) (pb.SignServiceClient, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+
+	service, err := NewSignServer(keyring)
+	assert.NoError(t, err)
+
+	recoveryHandler := recovery.WithRecoveryHandlerContext(RecoverPanic)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recovery.UnaryServerInterceptor(recoveryHandler), ValidationUnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(recovery.StreamServerInterceptor(recoveryHandler), ValidationStreamServerInterceptor()))
+	pb.RegisterSignServiceServer(server, service)
+
+	go func() {
+		assert.NoError(t, server.Serve(lis))
+	}()
+
+	conn, err := grpc.DialContext(ctx, "", grpc.WithContextDialer(
+		func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+
+	return pb.NewSignServiceClient(conn), func() {
+		assert.NoError(t, lis.Close())
+		server.Stop()
+	}
+}
+
+// fieldViolations returns the violated fields of an InvalidArgument error.
+func fieldViolations(t *testing.T, err error) []string {
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code(), err)
+
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	sign := &pb.DocSign{Sign: []byte{1}}
+	head := &pb.MerkleTreeHead{TreeSize: 1, RootHash: make([]byte, 32), Sign: []byte{1}, KeyId: "k"}
+
+	tests := []struct {
+		name       string
+		request    proto.Message
+		violations []string
+	}{
+		{name: "Case #1", request: &pb.VerifyRequest{Doc: &pb.Document{}, Sign: sign}},
+		{name: "Case #2", request: &pb.VerifyRequest{}, violations: []string{"doc", "sign"}},
+		{name: "Case #3", request: &pb.VerifyRequest{Doc: &pb.Document{}, Sign: &pb.DocSign{KeyId: "k", Algorithm: 42}}, violations: []string{"sign.sign", "sign.algorithm"}},
+		{name: "Case #4", request: &pb.VerifyBatchRequest{Docs: []*pb.VerifyRequest{{Doc: &pb.Document{}, Sign: sign}, {Sign: &pb.DocSign{}}}}},
+		{name: "Case #5", request: &pb.VerifyBatchRequest{}},
+		{name: "Case #6", request: &pb.VerifyDigestRequest{Digest: &pb.Digest{Hash: 7}, Sign: sign}, violations: []string{"digest.digest", "digest.hash"}},
+		{name: "Case #7", request: &pb.VerifyInclusionRequest{Doc: &pb.Document{}, Proof: &pb.InclusionProof{TreeHead: head}}},
+		{name: "Case #8", request: &pb.VerifyInclusionRequest{Doc: &pb.Document{}, Proof: &pb.InclusionProof{TreeHead: &pb.MerkleTreeHead{RootHash: make([]byte, 31)}}}, violations: []string{"proof.tree_head.root_hash", "proof.tree_head.sign", "proof.tree_head.key_id"}},
+		{name: "Case #9", request: &pb.VerifyInclusionRequest{Doc: &pb.Document{}, Proof: &pb.InclusionProof{}}, violations: []string{"proof.tree_head"}},
+		{name: "Case #10", request: &pb.SignSSHRequest{Data: []byte{1}}, violations: []string{"namespace"}},
+		{name: "Case #11", request: &pb.SignHTTPMessageRequest{Message: &pb.HTTPMessage{Fields: []*pb.HTTPField{{Name: "date"}, {Value: "v"}}}}, violations: []string{"message.fields[1].name"}},
+		{name: "Case #12", request: &pb.Document{}},
+		{name: "Case #13", request: &pb.LargeDocumentChunk{Part: &pb.LargeDocumentChunk_Header{Header: &pb.LargeDocumentHeader{Hash: 9}}}, violations: []string{"header.hash"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRequest(tt.request)
+			if tt.violations == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.violations, fieldViolations(t, err))
+		})
+	}
+}
+
+func TestValidationInterceptors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client, closer := serveValidated(t, ctx, NewKeyring(newTestKey(t)))
+	defer closer()
+
+	doc := &pb.Document{Data: randData(t, 17)}
+	sign, err := client.Sign(ctx, doc)
+	assert.NoError(t, err)
+
+	verification, err := client.Verify(ctx, &pb.VerifyRequest{Doc: doc, Sign: sign})
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+
+	_, err = client.Verify(ctx, &pb.VerifyRequest{Doc: doc})
+	assert.Equal(t, []string{"sign"}, fieldViolations(t, err))
+
+	// Batch items are checked one by one.
+	batch, err := client.VerifyBatch(ctx, &pb.VerifyBatchRequest{Docs: []*pb.VerifyRequest{{Doc: doc, Sign: sign}, {Sign: sign}}})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, batch.Status)
+	assert.Nil(t, batch.Results[0].Error)
+	assert.Equal(t, []string{"doc"}, fieldViolations(t, status.ErrorProto(batch.Results[1].Error)))
+
+	stream, err := client.VerifyStream(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, stream.Send(&pb.VerifyRequest{Doc: doc, Sign: sign}))
+	verification, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.True(t, verification.IsOk)
+
+	assert.NoError(t, stream.Send(&pb.VerifyRequest{Sign: &pb.DocSign{}}))
+	_, err = stream.Recv()
+	assert.Equal(t, []string{"doc", "sign.sign"}, fieldViolations(t, err))
+}
+
+func TestGrpcDocSignServer_MissingFields(t *testing.T) {
+	t.Parallel()
+
+	// Without the validation interceptor missing fields fail verification instead of panicking.
+	ctx := context.Background()
+	client, closer := serve(t, ctx)
+	defer closer()
+
+	for _, request := range []*pb.VerifyRequest{{}, {Doc: &pb.Document{}}, {Sign: &pb.DocSign{}}} {
+		verification, err := client.Verify(ctx, request)
+		assert.NoError(t, err)
+		assert.False(t, verification.IsOk)
+
+		batch, err := client.VerifyBatch(ctx, &pb.VerifyBatchRequest{Docs: []*pb.VerifyRequest{request}})
+		assert.NoError(t, err)
+		assert.False(t, batch.Status[0])
+
+		stream, err := client.VerifyStream(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, stream.Send(request))
+		verification, err = stream.Recv()
+		assert.NoError(t, err)
+		assert.False(t, verification.IsOk)
+	}
+
+	verification, err := client.VerifyDigest(ctx, &pb.VerifyDigestRequest{})
+	assert.NoError(t, err)
+	assert.False(t, verification.IsOk)
+}
+
+func TestRecoverPanic(t *testing.T) {
+	t.Parallel()
+
+	interceptor := recovery.UnaryServerInterceptor(recovery.WithRecoveryHandlerContext(RecoverPanic))
+	_, err := interceptor(context.Background(), &pb.VerifyRequest{}, &grpc.UnaryServerInfo{}, func(context.Context, any) (any, error) {
+		var request *pb.VerifyRequest
+		return request.Doc.Data, nil
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, err.Error(), "nil pointer")
+}
@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+
+	"github.com/cloudflare/circl/sign"
+	"github.com/r4start/sign-service/pkg/sshsig"
+)
+
+var (
+	ErrSignatureLength   = errors.New("bad signature length")
+	ErrSignatureEncoding = errors.New("bad signature encoding")
+	ErrUnknownKey        = errors.New("unknown key")
+	ErrRevokedKey        = errors.New("revoked key")
+	ErrSignatureMismatch = errors.New("signature mismatch")
+)
+
+// VerifyFailure tells why a signature does not verify.
+type VerifyFailure int
+
+const (
+	VerifyFailureNone VerifyFailure = iota
+	VerifyFailureBadLength
+	VerifyFailureBadEncoding
+	VerifyFailureUnknownKey
+	VerifyFailureRevokedKey
+	VerifyFailureExpired
+	VerifyFailureMismatch
+	VerifyFailureWrongPurpose
+)
+
+var _verifyFailureNames = map[VerifyFailure]string{
+	VerifyFailureNone:         "none",
+	VerifyFailureBadLength:    "bad length",
+	VerifyFailureBadEncoding:  "bad encoding",
+	VerifyFailureUnknownKey:   "unknown key",
+	VerifyFailureRevokedKey:   "revoked key",
+	VerifyFailureExpired:      "expired",
+	VerifyFailureMismatch:     "mismatch",
+	VerifyFailureWrongPurpose: "wrong purpose",
+}
+
+func (f VerifyFailure) String() string {
+	if name, ok := _verifyFailureNames[f]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// VerifyFailureOf classifies a verification error of a plain signature or of a signature format, errors of no known
+// class are mismatches.
+func VerifyFailureOf(err error) VerifyFailure {
+	switch {
+	case err == nil:
+		return VerifyFailureNone
+	case errors.Is(err, ErrSignatureLength):
+		return VerifyFailureBadLength
+	case errors.Is(err, ErrSignatureEncoding), errors.Is(err, ErrInvalidEnvelope), errors.Is(err, ErrMalformedInclusionProof):
+		return VerifyFailureBadEncoding
+	case errors.Is(err, ErrMalformedJWS), errors.Is(err, ErrMalformedCOSE), errors.Is(err, ErrMalformedCMS),
+		errors.Is(err, sshsig.ErrMalformed), errors.Is(err, sshsig.ErrUnsupportedVersion), errors.Is(err, ErrMalformedMinisign),
+		errors.Is(err, ErrMalformedDSSE), errors.Is(err, ErrMalformedTimestamp), errors.Is(err, ErrMalformedHTTPSignature):
+		return VerifyFailureBadEncoding
+	case errors.Is(err, ErrUnknownKey):
+		return VerifyFailureUnknownKey
+	case errors.Is(err, ErrRevokedKey):
+		return VerifyFailureRevokedKey
+	case errors.Is(err, ErrSignatureExpired):
+		return VerifyFailureExpired
+	case errors.Is(err, ErrPurposeMismatch), errors.Is(err, sshsig.ErrNamespaceMismatch):
+		return VerifyFailureWrongPurpose
+	default:
+		return VerifyFailureMismatch
+	}
+}
+
+// VerifyResult is the outcome of a signature check.
+type VerifyResult struct {
+	Failure VerifyFailure
+	// Err describes the failure.
+	Err error
+	// KeyID and Algorithm are those of the key which made the signature, as far as they are known.
+	KeyID     string
+	Algorithm Algorithm
+}
+
+// OK tells whether the signature verifies.
+func (r VerifyResult) OK() bool {
+	return r.Failure == VerifyFailureNone
+}
+
+func verifyResult(key *Key, keyID string, algorithm Algorithm, err error) VerifyResult {
+	result := VerifyResult{Failure: VerifyFailureOf(err), Err: err, KeyID: keyID, Algorithm: algorithm}
+	if key != nil {
+		result.KeyID, result.Algorithm = key.ID, key.Algorithm
+	}
+	return result
+}
+
+// VerifySignature checks a signature of data made by Key.Sign with the key id, the active key when id is empty.
+// The key must be of algorithm unless it is AlgorithmUnknown.
+func VerifySignature(keyring *Keyring, data, signature []byte, id string, algorithm Algorithm) VerifyResult {
+	key, err := keyring.verifyingKey(id, algorithm)
+	if err == nil {
+		err = checkSignatureFormat(key, signature)
+	}
+
+	if err == nil && !key.Verify(data, signature) {
+		err = ErrSignatureMismatch
+	}
+	return verifyResult(key, id, algorithm, err)
+}
+
+// VerifyDigestSignature checks a signature of a digest made by Key.SignDigest, see VerifySignature.
+func VerifyDigestSignature(keyring *Keyring, hash crypto.Hash, digest, signature []byte, id string, algorithm Algorithm) VerifyResult {
+	key, err := keyring.verifyingKey(id, algorithm)
+	if err == nil {
+		err = checkSignatureFormat(key, signature)
+	}
+
+	if err == nil && !key.VerifyDigest(hash, digest, signature) {
+		err = ErrSignatureMismatch
+	}
+	return verifyResult(key, id, algorithm, err)
+}
+
+// verifyingKey finds the key id, the active key when id is empty, and checks it is of algorithm unless it is
+// AlgorithmUnknown.
+func (k *Keyring) verifyingKey(id string, algorithm Algorithm) (*Key, error) {
+	if k.Revoked(id) {
+		return nil, fmt.Errorf("%w: %s", ErrRevokedKey, id)
+	}
+
+	key, ok := k.Lookup(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+
+	if algorithm != AlgorithmUnknown && algorithm != key.Algorithm {
+		return nil, fmt.Errorf("%w: %s is a %s key, not %s", ErrUnknownKey, key.ID, key.Algorithm, algorithm)
+	}
+	return key, nil
+}
+
+// checkSignatureFormat checks the length of a signature of key and the DER encoding of ECDSA signatures.
+func checkSignatureFormat(key *Key, signature []byte) error {
+	if size := key.Algorithm.ecdsaSize(); size != 0 {
+		// SEQUENCE of two INTEGERs of at most size bytes, each with a leading zero when the top bit is set.
+		if len(signature) < 8 || len(signature) > 2+2*(2+size+1) {
+			return fmt.Errorf("%w: %d bytes long %s signature", ErrSignatureLength, len(signature), key.Algorithm)
+		}
+
+		if _, err := key.Algorithm.rawSignature(signature); err != nil {
+			return fmt.Errorf("%w: %v", ErrSignatureEncoding, err)
+		}
+		return nil
+	}
+
+	var size int
+	switch publicKey := key.PublicKey.(type) {
+	case ed25519.PublicKey:
+		size = ed25519.SignatureSize
+	case *rsa.PublicKey:
+		size = publicKey.Size()
+	case sign.PublicKey:
+		size = publicKey.Scheme().SignatureSize()
+	case *HybridPublicKey:
+		size = HybridSignatureSize
+	}
+
+	if size != 0 && len(signature) != size {
+		return fmt.Errorf("%w: %d bytes long %s signature, expected %d", ErrSignatureLength, len(signature), key.Algorithm, size)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/r4start/sign-service/pkg/sshsig"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	data := randData(t, 100)
+
+	for _, algorithm := range []Algorithm{AlgorithmEd25519, AlgorithmECDSAP256SHA256, AlgorithmECDSAP384SHA384, AlgorithmRSAPSS2048SHA256, AlgorithmMLDSA44, AlgorithmEd25519MLDSA65} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			key, revoked := newTestAlgorithmKey(t, algorithm), newTestAlgorithmKey(t, algorithm)
+			keyring := NewKeyring(newTestKey(t), key.retire(), revoked.retire())
+			assert.NoError(t, keyring.Revoke(revoked.ID))
+
+			signature, err := key.Sign(data)
+			assert.NoError(t, err)
+			revokedSignature, err := revoked.Sign(data)
+			assert.NoError(t, err)
+
+			garbage := make([]byte, len(signature))
+			garbage[0] = 0x30
+
+			other := AlgorithmEd25519
+			if algorithm == AlgorithmEd25519 {
+				other = AlgorithmECDSAP256SHA256
+			}
+
+			tests := []struct {
+				name      string
+				data      []byte
+				signature []byte
+				id        string
+				algorithm Algorithm
+				failure   VerifyFailure
+			}{
+				{name: "Case #1", data: data, signature: signature, id: key.ID, algorithm: algorithm, failure: VerifyFailureNone},
+				{name: "Case #2", data: data, signature: signature, id: key.ID, algorithm: AlgorithmUnknown, failure: VerifyFailureNone},
+				{name: "Case #3", data: data[1:], signature: signature, id: key.ID, algorithm: algorithm, failure: VerifyFailureMismatch},
+				{name: "Case #4", data: data, signature: signature[:7], id: key.ID, algorithm: algorithm, failure: VerifyFailureBadLength},
+				{name: "Case #5", data: data, signature: append(signature, make([]byte, 40)...), id: key.ID, algorithm: algorithm, failure: VerifyFailureBadLength},
+				{name: "Case #6", data: data, signature: signature, id: newTestKey(t).ID, algorithm: algorithm, failure: VerifyFailureUnknownKey},
+				{name: "Case #7", data: data, signature: signature, id: key.ID, algorithm: other, failure: VerifyFailureUnknownKey},
+				{name: "Case #8", data: data, signature: revokedSignature, id: revoked.ID, algorithm: algorithm, failure: VerifyFailureRevokedKey},
+			}
+
+			// Only ECDSA signatures have an encoding which can be malformed.
+			if algorithm.ecdsaSize() != 0 {
+				tests = append(tests, struct {
+					name      string
+					data      []byte
+					signature []byte
+					id        string
+					algorithm Algorithm
+					failure   VerifyFailure
+				}{name: "Case #9", data: data, signature: garbage, id: key.ID, algorithm: algorithm, failure: VerifyFailureBadEncoding})
+			}
+
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					result := VerifySignature(keyring, tt.data, tt.signature, tt.id, tt.algorithm)
+					assert.Equal(t, tt.failure, result.Failure, result.Err)
+					assert.Equal(t, tt.failure == VerifyFailureNone, result.OK())
+					if result.OK() {
+						assert.Equal(t, key.ID, result.KeyID)
+						assert.Equal(t, algorithm, result.Algorithm)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestVerifyDigestSignature(t *testing.T) {
+	t.Parallel()
+
+	key := newTestAlgorithmKey(t, AlgorithmECDSAP256SHA256)
+	keyring := NewKeyring(key)
+
+	digest := sha256.Sum256(randData(t, 100))
+	signature, err := key.SignDigest(crypto.SHA256, digest[:])
+	assert.NoError(t, err)
+
+	result := VerifyDigestSignature(keyring, crypto.SHA256, digest[:], signature, "", AlgorithmUnknown)
+	assert.True(t, result.OK())
+	assert.Equal(t, key.ID, result.KeyID)
+
+	result = VerifyDigestSignature(keyring, crypto.SHA256, digest[1:], signature, key.ID, AlgorithmECDSAP256SHA256)
+	assert.Equal(t, VerifyFailureMismatch, result.Failure)
+
+	result = VerifyDigestSignature(keyring, crypto.SHA256, digest[:], signature[:7], key.ID, AlgorithmECDSAP256SHA256)
+	assert.Equal(t, VerifyFailureBadLength, result.Failure)
+}
+
+func TestVerifyFailureOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		err     error
+		failure VerifyFailure
+	}{
+		{name: "Case #1", err: nil, failure: VerifyFailureNone},
+		{name: "Case #2", err: fmt.Errorf("%w at now", ErrSignatureExpired), failure: VerifyFailureExpired},
+		{name: "Case #3", err: ErrPurposeMismatch, failure: VerifyFailureWrongPurpose},
+		{name: "Case #4", err: ErrInvalidEnvelope, failure: VerifyFailureBadEncoding},
+		{name: "Case #5", err: errEnvelopeMismatch, failure: VerifyFailureMismatch},
+		{name: "Case #6", err: errInclusionMismatch, failure: VerifyFailureMismatch},
+		{name: "Case #7", err: ErrRevokedKey, failure: VerifyFailureRevokedKey},
+		{name: "Case #8", err: errHTTPSignatureMismatch, failure: VerifyFailureMismatch},
+		{name: "Case #9", err: errHTTPSignatureExpired, failure: VerifyFailureExpired},
+		{name: "Case #10", err: fmt.Errorf("%w: %w %q", errJWSMismatch, ErrUnknownKey, "kid"), failure: VerifyFailureUnknownKey},
+		{name: "Case #11", err: fmt.Errorf("%w: header", ErrMalformedCOSE), failure: VerifyFailureBadEncoding},
+		{name: "Case #12", err: sshsig.ErrNamespaceMismatch, failure: VerifyFailureWrongPurpose},
+		{name: "Case #13", err: sshsig.ErrMalformed, failure: VerifyFailureBadEncoding},
+		{name: "Case #14", err: ErrMalformedTimestamp, failure: VerifyFailureBadEncoding},
+		{name: "Case #15", err: errDSSEMismatch, failure: VerifyFailureMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.failure, VerifyFailureOf(tt.err))
+		})
+	}
+	assert.Equal(t, "revoked key", VerifyFailureRevokedKey.String())
+}
+
+func TestKeyring_Revoke(t *testing.T) {
+	t.Parallel()
+
+	active, retired, cosigner := newTestKey(t), newTestKey(t), newTestKey(t)
+	keyring := NewKeyring(active, retired)
+	keyring.SetCosigners(cosigner)
+
+	assert.Error(t, keyring.Revoke(retired.ID, active.ID))
+	assert.False(t, keyring.Revoked(retired.ID))
+
+	assert.NoError(t, keyring.Revoke(retired.ID, cosigner.ID))
+	assert.True(t, keyring.Revoked(retired.ID))
+	assert.Len(t, keyring.Keys(), 1)
+
+	_, ok := keyring.Lookup(retired.ID)
+	assert.False(t, ok)
+	_, ok = keyring.Signer(cosigner.ID)
+	assert.False(t, ok)
+
+	// Reloads do not bring revoked keys back.
+	keyring.Rotate(newTestKey(t), retired)
+	keyring.SetCosigners(cosigner)
+	_, ok = keyring.Lookup(retired.ID)
+	assert.False(t, ok)
+	_, ok = keyring.Lookup(cosigner.ID)
+	assert.False(t, ok)
+	assert.Len(t, keyring.Keys(), 2)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/r4start/sign-service/cmd/docsign/internal"
+)
+
+const (
+	_newPassphraseEnv = "DOCSIGN_NEW_KEY_PASSPHRASE"
+)
+
+var errKeystoreUsage = errors.New("usage: docsign [-key path] keystore create [-import path] | passwd [-new-passphrase-fd fd] | inspect")
+
+// runKeystore manages passphrase-encrypted key files: create, passwd (change passphrase) and inspect.
+func runKeystore(args []string, keyPath string, algorithm internal.Algorithm, source internal.PassphraseSource) error {
+	if len(args) == 0 {
+		return errKeystoreUsage
+	}
+
+	command, args := args[0], args[1:]
+	flags := flag.NewFlagSet("keystore "+command, flag.ContinueOnError)
+
+	switch command {
+	case "create":
+		importPath := flags.String("import", "", "plain PKCS#8 PEM key to encrypt instead of generating a new one")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+
+		source.Prompt, source.Confirm = "New passphrase", true
+		passphrase, err := internal.ReadPassphrase(source)
+		if err != nil {
+			return err
+		}
+
+		if *importPath == "" {
+			publicKey, err := internal.CreateEncryptedKeyFile(keyPath, algorithm, passphrase)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("encrypted key %s written to %s\n", internal.KeyID(publicKey), keyPath)
+			return nil
+		}
+
+		privateKey, publicKey, err := internal.LoadPrivateKey(*importPath)
+		if err != nil {
+			return err
+		}
+
+		if err := internal.ImportEncryptedKeyFile(keyPath, privateKey, passphrase); err != nil {
+			return err
+		}
+		fmt.Printf("encrypted key %s written to %s\n", internal.KeyID(publicKey), keyPath)
+		return nil
+	case "passwd":
+		newFD := flags.Int("new-passphrase-fd", -1, "file descriptor to read the new passphrase from")
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+
+		source.Prompt = "Current passphrase"
+		passphrase, err := internal.ReadPassphrase(source)
+		if err != nil {
+			return err
+		}
+
+		newPassphrase, err := internal.ReadPassphrase(internal.PassphraseSource{
+			Env:     _newPassphraseEnv,
+			FD:      *newFD,
+			Prompt:  "New passphrase",
+			Confirm: true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := internal.ReencryptKeyFile(keyPath, passphrase, newPassphrase); err != nil {
+			return err
+		}
+		fmt.Printf("passphrase of %s changed\n", keyPath)
+		return nil
+	case "inspect":
+		if err := flags.Parse(args); err != nil {
+			return err
+		}
+
+		header, err := internal.ReadEncryptedKeyHeader(keyPath)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("file:    %s\n", keyPath)
+		fmt.Printf("version: %d\n", header.Version)
+		fmt.Printf("kdf:     %s (time=%d, memory=%dKiB, threads=%d)\n", header.KDF, header.Time, header.Memory, header.Threads)
+		fmt.Printf("salt:    %x\n", header.Salt)
+		fmt.Printf("cipher:  AES-256-GCM\n")
+		return nil
+	default:
+		return errKeystoreUsage
+	}
+}
@@ -2,15 +2,22 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
+	"log"
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/ratelimit"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
-	"golang.org/x/crypto/ed25519"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -20,34 +27,249 @@ import (
 )
 
 const (
-	_addr     = "[::]:10116"
-	_httpAddr = "[::]:8080"
-	_rpsLimit = 120
+	_addr      = "[::]:10116"
+	_batchAddr = "[::]:10117"
+	_httpAddr  = "[::]:8080"
+	_rpsLimit  = 120
+
+	_defaultKeyPath = "docsign.key"
+
+	_passphraseEnv = "DOCSIGN_KEY_PASSPHRASE"
+	_pkcs11PINEnv  = "DOCSIGN_PKCS11_PIN"
 )
 
 func main() {
-	creds := insecure.NewCredentials()
-	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	keyPath := flag.String("key", _defaultKeyPath, "path to the PKCS#8 PEM encoded signing key")
+	certificatePath := flag.String("certificate", "", "path to the PEM encoded X.509 certificate of the signing key followed by its chain, required for CMS")
+	retiredKeysDir := flag.String("retired-keys", "", "directory with PEM encoded keys accepted for verification only")
+	revokedKeys := flag.String("revoked-keys", "", "comma separated ids of revoked keys, their signatures no longer verify")
+	cosigningKeysDir := flag.String("cosigning-keys", "", "directory with PKCS#8 PEM encoded keys which sign next to the active key in multi-signer formats")
+	keyStoreBackend := flag.String("keystore", internal.KeyStoreFile, "signing key backend: file, encrypted or pkcs11")
+	pkcs11Module := flag.String("pkcs11-module", "", "path to the PKCS#11 module")
+	pkcs11Token := flag.String("pkcs11-token", "", "label of the PKCS#11 token")
+	pkcs11KeyLabel := flag.String("pkcs11-key", "", "label of the PKCS#11 key pair")
+	openPGPUserID := flag.String("openpgp-user-id", internal.DefaultOpenPGPUserID, "user ID of exported OpenPGP keys, e.g. \"Release Signing <release@example.com>\"")
+	caKeyPath := flag.String("ca-key", "", "path to the PKCS#8 PEM encoded key of the certificate authority, which signs nothing but certificates; no certificates are issued when empty")
+	caCertificatePath := flag.String("ca-certificate", "", "path to the PEM encoded CA certificate of -ca-key followed by its chain")
+	caValidity := flag.Duration("ca-validity", internal.DefaultCertificateProfile.Validity, "validity of issued certificates")
+	caKeyUsage := flag.String("ca-key-usage", "digitalSignature", "comma separated key usages of issued certificates, e.g. digitalSignature,keyEncipherment")
+	caExtKeyUsage := flag.String("ca-ext-key-usage", "serverAuth,clientAuth", "comma separated extended key usages of issued certificates: serverAuth, clientAuth, codeSigning, emailProtection, timeStamping, OCSPSigning or any")
+	caPathLength := flag.Int("ca-path-length", -1, "issue CA certificates with this path length constraint instead of end entity certificates when not negative")
+	caSerials := flag.String("ca-serials", "", "file recording the serial numbers of issued certificates, kept in memory when empty")
+	tsaKeyPath := flag.String("tsa-key", "", "path to the PKCS#8 PEM encoded key of the time-stamp authority, which signs nothing but time-stamp tokens; no tokens are issued when empty")
+	tsaCertificatePath := flag.String("tsa-certificate", "", "path to the PEM encoded time-stamping certificate of -tsa-key followed by its chain")
+	tsaPolicy := flag.String("tsa-policy", "", "comma separated TSA policy OIDs of time-stamp tokens, the first one is the default; time-stamping is off when empty")
+	tsaAccuracy := flag.Duration("tsa-accuracy", time.Second, "accuracy of the time of time-stamp tokens")
+	tsaSerials := flag.String("tsa-serials", "", "file recording the last serial number of time-stamp tokens, kept in memory when empty")
+	batchMaxItems := flag.Int("batch-max-items", internal.DefaultBatchLimits.MaxItems, "documents of a SignBatch or VerifyBatch call beyond this number are rejected, no limit when zero")
+	batchMaxBytes := flag.Int("batch-max-bytes", internal.DefaultBatchLimits.MaxBytes, "documents which take a SignBatch or VerifyBatch call over this total size are rejected, must be positive")
+	passphraseFD := flag.Int("passphrase-fd", -1, "file descriptor to read the key passphrase from")
+	algorithmName := flag.String("algorithm", internal.AlgorithmEd25519.String(),
+		"algorithm of generated keys: ed25519, ecdsa-p256, ecdsa-p384, rsa-pss-2048, rsa-pss-3072, rsa-pss-4096, "+
+			"ml-dsa-44, ml-dsa-65, ml-dsa-87 or ed25519-ml-dsa-65")
+	flag.Parse()
+
+	algorithm, err := internal.ParseAlgorithm(*algorithmName)
 	if err != nil {
+		log.Fatalf("bad -algorithm: %v", err)
+	}
+
+	passphraseSource := internal.PassphraseSource{Env: _passphraseEnv, FD: *passphraseFD}
+	if flag.Arg(0) == "keystore" {
+		if err := runKeystore(flag.Args()[1:], *keyPath, algorithm, passphraseSource); err != nil {
+			log.Fatalf("keystore: %v", err)
+		}
 		return
 	}
 
-	service, _ := internal.NewSignServer(privateKey, publicKey)
+	if flag.Arg(0) == "keygen" {
+		publicKey, err := internal.GenerateKeyFile(*keyPath, algorithm)
+		if err != nil {
+			log.Fatalf("failed to generate key: %v", err)
+		}
+		fmt.Printf("%s key %s written to %s\n", algorithm, internal.KeyID(publicKey), *keyPath)
+		return
+	}
+
+	var passphrase []byte
+	if *keyStoreBackend == internal.KeyStoreEncrypted {
+		passphraseSource.Prompt = "Passphrase for " + *keyPath
+		if passphrase, err = internal.ReadPassphrase(passphraseSource); err != nil {
+			log.Fatalf("failed to read key passphrase: %v", err)
+		}
+	}
+
+	creds := insecure.NewCredentials()
+	keyStore, err := internal.NewKeyStore(internal.KeyStoreConfig{
+		Backend:    *keyStoreBackend,
+		Path:       *keyPath,
+		Passphrase: passphrase,
+		PKCS11: internal.PKCS11Config{
+			Module:     *pkcs11Module,
+			TokenLabel: *pkcs11Token,
+			PIN:        os.Getenv(_pkcs11PINEnv),
+			KeyLabel:   *pkcs11KeyLabel,
+		},
+	})
+	if err != nil {
+		log.Fatalf("failed to open keystore: %v", err)
+	}
+	defer keyStore.Close()
+
+	active, retired, err := internal.LoadKeys(keyStore, *retiredKeysDir)
+	if err != nil {
+		log.Fatalf("failed to load signing key: %v", err)
+	}
+
+	if active, err = internal.AttachCertificates(active, *certificatePath); err != nil {
+		log.Fatalf("failed to load certificate: %v", err)
+	}
+	keyring := internal.NewKeyring(active, retired...)
+	if err := keyring.Revoke(internal.ParseKeyIDs(*revokedKeys)...); err != nil {
+		log.Fatalf("bad -revoked-keys: %v", err)
+	}
+
+	cosigners, err := internal.LoadCosigningKeys(*cosigningKeysDir)
+	if err != nil {
+		log.Fatalf("failed to load co-signing keys: %v", err)
+	}
+	keyring.SetCosigners(cosigners...)
+
+	keyUsage, err := internal.ParseKeyUsage(*caKeyUsage)
+	if err != nil {
+		log.Fatalf("bad -ca-key-usage: %v", err)
+	}
+
+	extKeyUsage, err := internal.ParseExtKeyUsage(*caExtKeyUsage)
+	if err != nil {
+		log.Fatalf("bad -ca-ext-key-usage: %v", err)
+	}
+
+	serials := internal.NewSerialRegistry()
+	if *caSerials != "" {
+		if serials, err = internal.OpenSerialRegistry(*caSerials); err != nil {
+			log.Fatalf("failed to open serial registry: %v", err)
+		}
+	}
+	defer serials.Close()
+
+	caKey, err := internal.LoadAuthorityKey(*caKeyPath, *caCertificatePath)
+	if err != nil {
+		log.Fatalf("failed to load CA key: %v", err)
+	}
+
+	ca, err := internal.NewCertificateAuthority(caKey, internal.CertificateProfile{
+		Validity:    *caValidity,
+		KeyUsage:    keyUsage,
+		ExtKeyUsage: extKeyUsage,
+		PathLength:  *caPathLength,
+	}, serials)
+	if err != nil {
+		log.Fatalf("bad certificate profile: %v", err)
+	}
+
+	policies, err := internal.ParseTimestampPolicies(*tsaPolicy)
+	if err != nil {
+		log.Fatalf("bad -tsa-policy: %v", err)
+	}
+
+	timestampSerials := internal.NewTimestampSerials()
+	if *tsaSerials != "" {
+		if timestampSerials, err = internal.OpenTimestampSerials(*tsaSerials); err != nil {
+			log.Fatalf("failed to open time-stamp serials: %v", err)
+		}
+	}
+	defer timestampSerials.Close()
+
+	tsaKey, err := internal.LoadAuthorityKey(*tsaKeyPath, *tsaCertificatePath)
+	if err != nil {
+		log.Fatalf("failed to load TSA key: %v", err)
+	}
+
+	tsa, err := internal.NewTimestampAuthority(tsaKey, internal.TimestampPolicy{Policies: policies, Accuracy: *tsaAccuracy}, timestampSerials)
+	if err != nil {
+		log.Fatalf("bad time-stamp policy: %v", err)
+	}
+
+	// The batch server takes messages of twice -batch-max-bytes, a batch without a byte limit would still be bound by
+	// the gRPC default.
+	if *batchMaxBytes <= 0 {
+		log.Fatalf("bad -batch-max-bytes: %d is not positive", *batchMaxBytes)
+	}
+	batchLimits := internal.BatchLimits{MaxItems: *batchMaxItems, MaxBytes: *batchMaxBytes}
+	service, err := internal.NewSignServer(keyring,
+		internal.WithOpenPGPUserID(*openPGPUserID),
+		internal.WithCertificateAuthority(ca),
+		internal.WithTimestampAuthority(tsa),
+		internal.WithBatchLimits(batchLimits))
+	if err != nil {
+		log.Fatalf("failed to create sign server: %v", err)
+	}
+
+	// SIGHUP reloads keys from the keystore, a new active key retires the previous one.
+	go func() {
+		reload := make(chan os.Signal, 1)
+		signal.Notify(reload, syscall.SIGHUP)
+		for range reload {
+			active, retired, err := internal.LoadKeys(keyStore, *retiredKeysDir)
+			if err != nil {
+				log.Printf("failed to reload keys: %v", err)
+				continue
+			}
+
+			if active, err = internal.AttachCertificates(active, *certificatePath); err != nil {
+				log.Printf("failed to reload certificate: %v", err)
+				continue
+			}
+
+			if keyring.Revoked(active.ID) {
+				log.Printf("failed to reload keys: signing key %s is revoked", active.ID)
+				continue
+			}
+
+			if err := service.CheckSigningKeys(active); err != nil {
+				log.Printf("failed to reload keys: %v", err)
+				continue
+			}
+			keyring.Rotate(active, retired...)
+
+			cosigners, err := internal.LoadCosigningKeys(*cosigningKeysDir)
+			if err != nil {
+				log.Printf("failed to reload co-signing keys: %v", err)
+				continue
+			}
+
+			if err := service.CheckSigningKeys(cosigners...); err != nil {
+				log.Printf("failed to reload co-signing keys: %v", err)
+				continue
+			}
+			keyring.SetCosigners(cosigners...)
+			log.Printf("active signing key %s", active.ID)
+		}
+	}()
 	authFunc := internal.BuildAuthorizationInterceptor()
+	recoveryHandler := recovery.WithRecoveryHandlerContext(internal.RecoverPanic)
 
-	server := grpc.NewServer(grpc.Creds(creds),
+	serverOptions := []grpc.ServerOption{grpc.Creds(creds),
 		grpc.ChainUnaryInterceptor(
+			recovery.UnaryServerInterceptor(recoveryHandler),
 			ratelimit.UnaryServerInterceptor(internal.NewLimiter(_rpsLimit)),
 			selector.UnaryServerInterceptor(
 				grpcauth.UnaryServerInterceptor(authFunc),
 				selector.MatchFunc(internal.AllButReflection)),
+			internal.ValidationUnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			recovery.StreamServerInterceptor(recoveryHandler),
 			ratelimit.StreamServerInterceptor(internal.NewLimiter(_rpsLimit)),
 			selector.StreamServerInterceptor(
 				grpcauth.StreamServerInterceptor(authFunc),
 				selector.MatchFunc(internal.AllButReflection)),
-		))
+			internal.ValidationStreamServerInterceptor(),
+		),
+	}
+
+	server := grpc.NewServer(serverOptions...)
 	pb.RegisterSignServiceServer(server, service)
 
 	listener, err := net.Listen("tcp", _addr)
@@ -57,6 +279,22 @@ func main() {
 
 	reflection.Register(server)
 
+	// Batches up to -batch-max-bytes take larger messages than the gRPC default, only the batch server accepts them.
+	batchServer := grpc.NewServer(append(serverOptions[:len(serverOptions):len(serverOptions)],
+		grpc.MaxRecvMsgSize(batchLimits.MessageSize()))...)
+	pb.RegisterSignServiceServer(batchServer, service.BatchService())
+
+	batchListener, err := net.Listen("tcp", _batchAddr)
+	if err != nil {
+		return
+	}
+
+	go func() {
+		if err := batchServer.Serve(batchListener); err != nil {
+			log.Printf("batch server stopped: %v", err)
+		}
+	}()
+
 	go func() {
 		ctx := context.Background()
 		ctx, cancel := context.WithCancel(ctx)
@@ -64,10 +302,39 @@ func main() {
 
 		mux := runtime.NewServeMux()
 		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
-		err := pb.RegisterSignServiceHandlerFromEndpoint(ctx, mux, _addr, opts)
+		conn, err := grpc.DialContext(ctx, _addr, opts...)
 		if err != nil {
 			return
 		}
+		defer conn.Close()
+
+		size := batchLimits.MessageSize()
+		batchConn, err := grpc.DialContext(ctx, _batchAddr, append(opts,
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(size), grpc.MaxCallSendMsgSize(size)))...)
+		if err != nil {
+			return
+		}
+		defer batchConn.Close()
+
+		client := pb.NewSignServiceClient(conn)
+		if err := pb.RegisterSignServiceHandlerClient(ctx, mux, internal.NewBatchRoutingClient(client, pb.NewSignServiceClient(batchConn))); err != nil {
+			return
+		}
+		if err := mux.HandlePath(http.MethodPost, internal.LargeDocumentSignPath, internal.SignLargeDocumentHandler(mux, client)); err != nil {
+			return
+		}
+
+		if err := mux.HandlePath(http.MethodPost, internal.LargeDocumentVerifyPath, internal.VerifyLargeDocumentHandler(mux, client)); err != nil {
+			return
+		}
+
+		if err := mux.HandlePath(http.MethodPost, internal.TimestampPath, internal.TimestampHandler(mux, client)); err != nil {
+			return
+		}
+
+		if err := mux.HandlePath(http.MethodGet, internal.JWKSPath, internal.JWKSHandler(keyring)); err != nil {
+			return
+		}
 
 		// Start HTTP server (and proxy calls to gRPC server endpoint)
 		http.ListenAndServe(_httpAddr, mux)
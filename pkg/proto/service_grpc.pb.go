@@ -19,12 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SignService_Sign_FullMethodName         = "/signservice.SignService/Sign"
-	SignService_Verify_FullMethodName       = "/signservice.SignService/Verify"
-	SignService_SignBatch_FullMethodName    = "/signservice.SignService/SignBatch"
-	SignService_VerifyBatch_FullMethodName  = "/signservice.SignService/VerifyBatch"
-	SignService_SignStream_FullMethodName   = "/signservice.SignService/SignStream"
-	SignService_VerifyStream_FullMethodName = "/signservice.SignService/VerifyStream"
+	SignService_Sign_FullMethodName                 = "/signservice.SignService/Sign"
+	SignService_Verify_FullMethodName               = "/signservice.SignService/Verify"
+	SignService_SignBatch_FullMethodName            = "/signservice.SignService/SignBatch"
+	SignService_VerifyBatch_FullMethodName          = "/signservice.SignService/VerifyBatch"
+	SignService_VerifyInclusion_FullMethodName      = "/signservice.SignService/VerifyInclusion"
+	SignService_SignStream_FullMethodName           = "/signservice.SignService/SignStream"
+	SignService_VerifyStream_FullMethodName         = "/signservice.SignService/VerifyStream"
+	SignService_SignDigest_FullMethodName           = "/signservice.SignService/SignDigest"
+	SignService_VerifyDigest_FullMethodName         = "/signservice.SignService/VerifyDigest"
+	SignService_SignLargeDocument_FullMethodName    = "/signservice.SignService/SignLargeDocument"
+	SignService_VerifyLargeDocument_FullMethodName  = "/signservice.SignService/VerifyLargeDocument"
+	SignService_SignJWS_FullMethodName              = "/signservice.SignService/SignJWS"
+	SignService_VerifyJWS_FullMethodName            = "/signservice.SignService/VerifyJWS"
+	SignService_SignCOSE_FullMethodName             = "/signservice.SignService/SignCOSE"
+	SignService_VerifyCOSE_FullMethodName           = "/signservice.SignService/VerifyCOSE"
+	SignService_SignCMS_FullMethodName              = "/signservice.SignService/SignCMS"
+	SignService_VerifyCMS_FullMethodName            = "/signservice.SignService/VerifyCMS"
+	SignService_SignOpenPGP_FullMethodName          = "/signservice.SignService/SignOpenPGP"
+	SignService_GetOpenPGPPublicKey_FullMethodName  = "/signservice.SignService/GetOpenPGPPublicKey"
+	SignService_SignSSH_FullMethodName              = "/signservice.SignService/SignSSH"
+	SignService_VerifySSH_FullMethodName            = "/signservice.SignService/VerifySSH"
+	SignService_SignMinisign_FullMethodName         = "/signservice.SignService/SignMinisign"
+	SignService_VerifyMinisign_FullMethodName       = "/signservice.SignService/VerifyMinisign"
+	SignService_GetMinisignPublicKey_FullMethodName = "/signservice.SignService/GetMinisignPublicKey"
+	SignService_SignDSSE_FullMethodName             = "/signservice.SignService/SignDSSE"
+	SignService_VerifyDSSE_FullMethodName           = "/signservice.SignService/VerifyDSSE"
+	SignService_SignCSR_FullMethodName              = "/signservice.SignService/SignCSR"
+	SignService_IssueCertificate_FullMethodName     = "/signservice.SignService/IssueCertificate"
+	SignService_Timestamp_FullMethodName            = "/signservice.SignService/Timestamp"
+	SignService_VerifyTimestamp_FullMethodName      = "/signservice.SignService/VerifyTimestamp"
+	SignService_SignHTTPMessage_FullMethodName      = "/signservice.SignService/SignHTTPMessage"
+	SignService_VerifyHTTPMessage_FullMethodName    = "/signservice.SignService/VerifyHTTPMessage"
+	SignService_GetPublicKey_FullMethodName         = "/signservice.SignService/GetPublicKey"
+	SignService_ListKeys_FullMethodName             = "/signservice.SignService/ListKeys"
 )
 
 // SignServiceClient is the client API for SignService service.
@@ -35,9 +63,52 @@ type SignServiceClient interface {
 	Verify(ctx context.Context, in *VerifyRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
 	SignBatch(ctx context.Context, in *DocumentBatch, opts ...grpc.CallOption) (*DocSignBatch, error)
 	VerifyBatch(ctx context.Context, in *VerifyBatchRequest, opts ...grpc.CallOption) (*VerifyBatchResponse, error)
+	// Checks a document of a Merkle tree batch against its inclusion proof and signed tree head.
+	VerifyInclusion(ctx context.Context, in *VerifyInclusionRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
 	// Streaming API
 	SignStream(ctx context.Context, opts ...grpc.CallOption) (SignService_SignStreamClient, error)
 	VerifyStream(ctx context.Context, opts ...grpc.CallOption) (SignService_VerifyStreamClient, error)
+	// Pre-hashed API: clients hash documents locally and send the digest only.
+	SignDigest(ctx context.Context, in *Digest, opts ...grpc.CallOption) (*DocSign, error)
+	VerifyDigest(ctx context.Context, in *VerifyDigestRequest, opts ...grpc.CallOption) (*VerifyResponse, error)
+	// Large document API: the client sends a LargeDocumentHeader followed by any number of data chunks and gets one
+	// signature of the whole document once the stream is closed.
+	SignLargeDocument(ctx context.Context, opts ...grpc.CallOption) (SignService_SignLargeDocumentClient, error)
+	VerifyLargeDocument(ctx context.Context, opts ...grpc.CallOption) (SignService_VerifyLargeDocumentClient, error)
+	// JOSE API
+	SignJWS(ctx context.Context, in *SignJWSRequest, opts ...grpc.CallOption) (*SignJWSResponse, error)
+	VerifyJWS(ctx context.Context, in *VerifyJWSRequest, opts ...grpc.CallOption) (*VerifyJWSResponse, error)
+	// COSE API
+	SignCOSE(ctx context.Context, in *SignCOSERequest, opts ...grpc.CallOption) (*SignCOSEResponse, error)
+	VerifyCOSE(ctx context.Context, in *VerifyCOSERequest, opts ...grpc.CallOption) (*VerifyCOSEResponse, error)
+	// CMS API
+	SignCMS(ctx context.Context, in *SignCMSRequest, opts ...grpc.CallOption) (*SignCMSResponse, error)
+	VerifyCMS(ctx context.Context, in *VerifyCMSRequest, opts ...grpc.CallOption) (*VerifyCMSResponse, error)
+	// OpenPGP API
+	SignOpenPGP(ctx context.Context, in *SignOpenPGPRequest, opts ...grpc.CallOption) (*SignOpenPGPResponse, error)
+	GetOpenPGPPublicKey(ctx context.Context, in *GetOpenPGPPublicKeyRequest, opts ...grpc.CallOption) (*OpenPGPPublicKey, error)
+	// SSH API
+	SignSSH(ctx context.Context, in *SignSSHRequest, opts ...grpc.CallOption) (*SignSSHResponse, error)
+	VerifySSH(ctx context.Context, in *VerifySSHRequest, opts ...grpc.CallOption) (*VerifySSHResponse, error)
+	// minisign API
+	SignMinisign(ctx context.Context, in *SignMinisignRequest, opts ...grpc.CallOption) (*SignMinisignResponse, error)
+	VerifyMinisign(ctx context.Context, in *VerifyMinisignRequest, opts ...grpc.CallOption) (*VerifyMinisignResponse, error)
+	GetMinisignPublicKey(ctx context.Context, in *GetMinisignPublicKeyRequest, opts ...grpc.CallOption) (*MinisignPublicKey, error)
+	// DSSE API
+	SignDSSE(ctx context.Context, in *SignDSSERequest, opts ...grpc.CallOption) (*SignDSSEResponse, error)
+	VerifyDSSE(ctx context.Context, in *VerifyDSSERequest, opts ...grpc.CallOption) (*VerifyDSSEResponse, error)
+	// Certificate authority API
+	SignCSR(ctx context.Context, in *SignCSRRequest, opts ...grpc.CallOption) (*IssuedCertificate, error)
+	IssueCertificate(ctx context.Context, in *IssueCertificateRequest, opts ...grpc.CallOption) (*IssuedCertificate, error)
+	// Time-stamp API: RFC 3161 time-stamp tokens, also served as application/timestamp-query over HTTP.
+	Timestamp(ctx context.Context, in *TimestampRequest, opts ...grpc.CallOption) (*TimestampResponse, error)
+	VerifyTimestamp(ctx context.Context, in *VerifyTimestampRequest, opts ...grpc.CallOption) (*VerifyTimestampResponse, error)
+	// HTTP message signatures API: RFC 9421 Signature-Input and Signature fields of HTTP requests and responses.
+	SignHTTPMessage(ctx context.Context, in *SignHTTPMessageRequest, opts ...grpc.CallOption) (*SignHTTPMessageResponse, error)
+	VerifyHTTPMessage(ctx context.Context, in *VerifyHTTPMessageRequest, opts ...grpc.CallOption) (*VerifyHTTPMessageResponse, error)
+	// Key discovery API
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*PublicKey, error)
+	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
 }
 
 type signServiceClient struct {
@@ -84,6 +155,15 @@ func (c *signServiceClient) VerifyBatch(ctx context.Context, in *VerifyBatchRequ
 	return out, nil
 }
 
+func (c *signServiceClient) VerifyInclusion(ctx context.Context, in *VerifyInclusionRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
+	out := new(VerifyResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyInclusion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *signServiceClient) SignStream(ctx context.Context, opts ...grpc.CallOption) (SignService_SignStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &SignService_ServiceDesc.Streams[0], SignService_SignStream_FullMethodName, opts...)
 	if err != nil {
@@ -146,6 +226,299 @@ func (x *signServiceVerifyStreamClient) Recv() (*VerifyResponse, error) {
 	return m, nil
 }
 
+func (c *signServiceClient) SignDigest(ctx context.Context, in *Digest, opts ...grpc.CallOption) (*DocSign, error) {
+	out := new(DocSign)
+	err := c.cc.Invoke(ctx, SignService_SignDigest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyDigest(ctx context.Context, in *VerifyDigestRequest, opts ...grpc.CallOption) (*VerifyResponse, error) {
+	out := new(VerifyResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyDigest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignLargeDocument(ctx context.Context, opts ...grpc.CallOption) (SignService_SignLargeDocumentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SignService_ServiceDesc.Streams[2], SignService_SignLargeDocument_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &signServiceSignLargeDocumentClient{stream}
+	return x, nil
+}
+
+type SignService_SignLargeDocumentClient interface {
+	Send(*LargeDocumentChunk) error
+	CloseAndRecv() (*DocSign, error)
+	grpc.ClientStream
+}
+
+type signServiceSignLargeDocumentClient struct {
+	grpc.ClientStream
+}
+
+func (x *signServiceSignLargeDocumentClient) Send(m *LargeDocumentChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *signServiceSignLargeDocumentClient) CloseAndRecv() (*DocSign, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(DocSign)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *signServiceClient) VerifyLargeDocument(ctx context.Context, opts ...grpc.CallOption) (SignService_VerifyLargeDocumentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SignService_ServiceDesc.Streams[3], SignService_VerifyLargeDocument_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &signServiceVerifyLargeDocumentClient{stream}
+	return x, nil
+}
+
+type SignService_VerifyLargeDocumentClient interface {
+	Send(*LargeDocumentChunk) error
+	CloseAndRecv() (*VerifyResponse, error)
+	grpc.ClientStream
+}
+
+type signServiceVerifyLargeDocumentClient struct {
+	grpc.ClientStream
+}
+
+func (x *signServiceVerifyLargeDocumentClient) Send(m *LargeDocumentChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *signServiceVerifyLargeDocumentClient) CloseAndRecv() (*VerifyResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(VerifyResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *signServiceClient) SignJWS(ctx context.Context, in *SignJWSRequest, opts ...grpc.CallOption) (*SignJWSResponse, error) {
+	out := new(SignJWSResponse)
+	err := c.cc.Invoke(ctx, SignService_SignJWS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyJWS(ctx context.Context, in *VerifyJWSRequest, opts ...grpc.CallOption) (*VerifyJWSResponse, error) {
+	out := new(VerifyJWSResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyJWS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignCOSE(ctx context.Context, in *SignCOSERequest, opts ...grpc.CallOption) (*SignCOSEResponse, error) {
+	out := new(SignCOSEResponse)
+	err := c.cc.Invoke(ctx, SignService_SignCOSE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyCOSE(ctx context.Context, in *VerifyCOSERequest, opts ...grpc.CallOption) (*VerifyCOSEResponse, error) {
+	out := new(VerifyCOSEResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyCOSE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignCMS(ctx context.Context, in *SignCMSRequest, opts ...grpc.CallOption) (*SignCMSResponse, error) {
+	out := new(SignCMSResponse)
+	err := c.cc.Invoke(ctx, SignService_SignCMS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyCMS(ctx context.Context, in *VerifyCMSRequest, opts ...grpc.CallOption) (*VerifyCMSResponse, error) {
+	out := new(VerifyCMSResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyCMS_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignOpenPGP(ctx context.Context, in *SignOpenPGPRequest, opts ...grpc.CallOption) (*SignOpenPGPResponse, error) {
+	out := new(SignOpenPGPResponse)
+	err := c.cc.Invoke(ctx, SignService_SignOpenPGP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) GetOpenPGPPublicKey(ctx context.Context, in *GetOpenPGPPublicKeyRequest, opts ...grpc.CallOption) (*OpenPGPPublicKey, error) {
+	out := new(OpenPGPPublicKey)
+	err := c.cc.Invoke(ctx, SignService_GetOpenPGPPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignSSH(ctx context.Context, in *SignSSHRequest, opts ...grpc.CallOption) (*SignSSHResponse, error) {
+	out := new(SignSSHResponse)
+	err := c.cc.Invoke(ctx, SignService_SignSSH_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifySSH(ctx context.Context, in *VerifySSHRequest, opts ...grpc.CallOption) (*VerifySSHResponse, error) {
+	out := new(VerifySSHResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifySSH_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignMinisign(ctx context.Context, in *SignMinisignRequest, opts ...grpc.CallOption) (*SignMinisignResponse, error) {
+	out := new(SignMinisignResponse)
+	err := c.cc.Invoke(ctx, SignService_SignMinisign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyMinisign(ctx context.Context, in *VerifyMinisignRequest, opts ...grpc.CallOption) (*VerifyMinisignResponse, error) {
+	out := new(VerifyMinisignResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyMinisign_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) GetMinisignPublicKey(ctx context.Context, in *GetMinisignPublicKeyRequest, opts ...grpc.CallOption) (*MinisignPublicKey, error) {
+	out := new(MinisignPublicKey)
+	err := c.cc.Invoke(ctx, SignService_GetMinisignPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignDSSE(ctx context.Context, in *SignDSSERequest, opts ...grpc.CallOption) (*SignDSSEResponse, error) {
+	out := new(SignDSSEResponse)
+	err := c.cc.Invoke(ctx, SignService_SignDSSE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyDSSE(ctx context.Context, in *VerifyDSSERequest, opts ...grpc.CallOption) (*VerifyDSSEResponse, error) {
+	out := new(VerifyDSSEResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyDSSE_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignCSR(ctx context.Context, in *SignCSRRequest, opts ...grpc.CallOption) (*IssuedCertificate, error) {
+	out := new(IssuedCertificate)
+	err := c.cc.Invoke(ctx, SignService_SignCSR_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) IssueCertificate(ctx context.Context, in *IssueCertificateRequest, opts ...grpc.CallOption) (*IssuedCertificate, error) {
+	out := new(IssuedCertificate)
+	err := c.cc.Invoke(ctx, SignService_IssueCertificate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) Timestamp(ctx context.Context, in *TimestampRequest, opts ...grpc.CallOption) (*TimestampResponse, error) {
+	out := new(TimestampResponse)
+	err := c.cc.Invoke(ctx, SignService_Timestamp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyTimestamp(ctx context.Context, in *VerifyTimestampRequest, opts ...grpc.CallOption) (*VerifyTimestampResponse, error) {
+	out := new(VerifyTimestampResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyTimestamp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) SignHTTPMessage(ctx context.Context, in *SignHTTPMessageRequest, opts ...grpc.CallOption) (*SignHTTPMessageResponse, error) {
+	out := new(SignHTTPMessageResponse)
+	err := c.cc.Invoke(ctx, SignService_SignHTTPMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) VerifyHTTPMessage(ctx context.Context, in *VerifyHTTPMessageRequest, opts ...grpc.CallOption) (*VerifyHTTPMessageResponse, error) {
+	out := new(VerifyHTTPMessageResponse)
+	err := c.cc.Invoke(ctx, SignService_VerifyHTTPMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*PublicKey, error) {
+	out := new(PublicKey)
+	err := c.cc.Invoke(ctx, SignService_GetPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *signServiceClient) ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error) {
+	out := new(ListKeysResponse)
+	err := c.cc.Invoke(ctx, SignService_ListKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SignServiceServer is the server API for SignService service.
 // All implementations must embed UnimplementedSignServiceServer
 // for forward compatibility
@@ -154,9 +527,52 @@ type SignServiceServer interface {
 	Verify(context.Context, *VerifyRequest) (*VerifyResponse, error)
 	SignBatch(context.Context, *DocumentBatch) (*DocSignBatch, error)
 	VerifyBatch(context.Context, *VerifyBatchRequest) (*VerifyBatchResponse, error)
+	// Checks a document of a Merkle tree batch against its inclusion proof and signed tree head.
+	VerifyInclusion(context.Context, *VerifyInclusionRequest) (*VerifyResponse, error)
 	// Streaming API
 	SignStream(SignService_SignStreamServer) error
 	VerifyStream(SignService_VerifyStreamServer) error
+	// Pre-hashed API: clients hash documents locally and send the digest only.
+	SignDigest(context.Context, *Digest) (*DocSign, error)
+	VerifyDigest(context.Context, *VerifyDigestRequest) (*VerifyResponse, error)
+	// Large document API: the client sends a LargeDocumentHeader followed by any number of data chunks and gets one
+	// signature of the whole document once the stream is closed.
+	SignLargeDocument(SignService_SignLargeDocumentServer) error
+	VerifyLargeDocument(SignService_VerifyLargeDocumentServer) error
+	// JOSE API
+	SignJWS(context.Context, *SignJWSRequest) (*SignJWSResponse, error)
+	VerifyJWS(context.Context, *VerifyJWSRequest) (*VerifyJWSResponse, error)
+	// COSE API
+	SignCOSE(context.Context, *SignCOSERequest) (*SignCOSEResponse, error)
+	VerifyCOSE(context.Context, *VerifyCOSERequest) (*VerifyCOSEResponse, error)
+	// CMS API
+	SignCMS(context.Context, *SignCMSRequest) (*SignCMSResponse, error)
+	VerifyCMS(context.Context, *VerifyCMSRequest) (*VerifyCMSResponse, error)
+	// OpenPGP API
+	SignOpenPGP(context.Context, *SignOpenPGPRequest) (*SignOpenPGPResponse, error)
+	GetOpenPGPPublicKey(context.Context, *GetOpenPGPPublicKeyRequest) (*OpenPGPPublicKey, error)
+	// SSH API
+	SignSSH(context.Context, *SignSSHRequest) (*SignSSHResponse, error)
+	VerifySSH(context.Context, *VerifySSHRequest) (*VerifySSHResponse, error)
+	// minisign API
+	SignMinisign(context.Context, *SignMinisignRequest) (*SignMinisignResponse, error)
+	VerifyMinisign(context.Context, *VerifyMinisignRequest) (*VerifyMinisignResponse, error)
+	GetMinisignPublicKey(context.Context, *GetMinisignPublicKeyRequest) (*MinisignPublicKey, error)
+	// DSSE API
+	SignDSSE(context.Context, *SignDSSERequest) (*SignDSSEResponse, error)
+	VerifyDSSE(context.Context, *VerifyDSSERequest) (*VerifyDSSEResponse, error)
+	// Certificate authority API
+	SignCSR(context.Context, *SignCSRRequest) (*IssuedCertificate, error)
+	IssueCertificate(context.Context, *IssueCertificateRequest) (*IssuedCertificate, error)
+	// Time-stamp API: RFC 3161 time-stamp tokens, also served as application/timestamp-query over HTTP.
+	Timestamp(context.Context, *TimestampRequest) (*TimestampResponse, error)
+	VerifyTimestamp(context.Context, *VerifyTimestampRequest) (*VerifyTimestampResponse, error)
+	// HTTP message signatures API: RFC 9421 Signature-Input and Signature fields of HTTP requests and responses.
+	SignHTTPMessage(context.Context, *SignHTTPMessageRequest) (*SignHTTPMessageResponse, error)
+	VerifyHTTPMessage(context.Context, *VerifyHTTPMessageRequest) (*VerifyHTTPMessageResponse, error)
+	// Key discovery API
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*PublicKey, error)
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
 	mustEmbedUnimplementedSignServiceServer()
 }
 
@@ -176,12 +592,96 @@ func (UnimplementedSignServiceServer) SignBatch(context.Context, *DocumentBatch)
 func (UnimplementedSignServiceServer) VerifyBatch(context.Context, *VerifyBatchRequest) (*VerifyBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyBatch not implemented")
 }
+func (UnimplementedSignServiceServer) VerifyInclusion(context.Context, *VerifyInclusionRequest) (*VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyInclusion not implemented")
+}
 func (UnimplementedSignServiceServer) SignStream(SignService_SignStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method SignStream not implemented")
 }
 func (UnimplementedSignServiceServer) VerifyStream(SignService_VerifyStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method VerifyStream not implemented")
 }
+func (UnimplementedSignServiceServer) SignDigest(context.Context, *Digest) (*DocSign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignDigest not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyDigest(context.Context, *VerifyDigestRequest) (*VerifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDigest not implemented")
+}
+func (UnimplementedSignServiceServer) SignLargeDocument(SignService_SignLargeDocumentServer) error {
+	return status.Errorf(codes.Unimplemented, "method SignLargeDocument not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyLargeDocument(SignService_VerifyLargeDocumentServer) error {
+	return status.Errorf(codes.Unimplemented, "method VerifyLargeDocument not implemented")
+}
+func (UnimplementedSignServiceServer) SignJWS(context.Context, *SignJWSRequest) (*SignJWSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignJWS not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyJWS(context.Context, *VerifyJWSRequest) (*VerifyJWSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyJWS not implemented")
+}
+func (UnimplementedSignServiceServer) SignCOSE(context.Context, *SignCOSERequest) (*SignCOSEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCOSE not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyCOSE(context.Context, *VerifyCOSERequest) (*VerifyCOSEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCOSE not implemented")
+}
+func (UnimplementedSignServiceServer) SignCMS(context.Context, *SignCMSRequest) (*SignCMSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCMS not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyCMS(context.Context, *VerifyCMSRequest) (*VerifyCMSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCMS not implemented")
+}
+func (UnimplementedSignServiceServer) SignOpenPGP(context.Context, *SignOpenPGPRequest) (*SignOpenPGPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignOpenPGP not implemented")
+}
+func (UnimplementedSignServiceServer) GetOpenPGPPublicKey(context.Context, *GetOpenPGPPublicKeyRequest) (*OpenPGPPublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenPGPPublicKey not implemented")
+}
+func (UnimplementedSignServiceServer) SignSSH(context.Context, *SignSSHRequest) (*SignSSHResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSSH not implemented")
+}
+func (UnimplementedSignServiceServer) VerifySSH(context.Context, *VerifySSHRequest) (*VerifySSHResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySSH not implemented")
+}
+func (UnimplementedSignServiceServer) SignMinisign(context.Context, *SignMinisignRequest) (*SignMinisignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMinisign not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyMinisign(context.Context, *VerifyMinisignRequest) (*VerifyMinisignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMinisign not implemented")
+}
+func (UnimplementedSignServiceServer) GetMinisignPublicKey(context.Context, *GetMinisignPublicKeyRequest) (*MinisignPublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinisignPublicKey not implemented")
+}
+func (UnimplementedSignServiceServer) SignDSSE(context.Context, *SignDSSERequest) (*SignDSSEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignDSSE not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyDSSE(context.Context, *VerifyDSSERequest) (*VerifyDSSEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDSSE not implemented")
+}
+func (UnimplementedSignServiceServer) SignCSR(context.Context, *SignCSRRequest) (*IssuedCertificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignCSR not implemented")
+}
+func (UnimplementedSignServiceServer) IssueCertificate(context.Context, *IssueCertificateRequest) (*IssuedCertificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCertificate not implemented")
+}
+func (UnimplementedSignServiceServer) Timestamp(context.Context, *TimestampRequest) (*TimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Timestamp not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyTimestamp(context.Context, *VerifyTimestampRequest) (*VerifyTimestampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTimestamp not implemented")
+}
+func (UnimplementedSignServiceServer) SignHTTPMessage(context.Context, *SignHTTPMessageRequest) (*SignHTTPMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignHTTPMessage not implemented")
+}
+func (UnimplementedSignServiceServer) VerifyHTTPMessage(context.Context, *VerifyHTTPMessageRequest) (*VerifyHTTPMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyHTTPMessage not implemented")
+}
+func (UnimplementedSignServiceServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*PublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedSignServiceServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
 func (UnimplementedSignServiceServer) mustEmbedUnimplementedSignServiceServer() {}
 
 // UnsafeSignServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -267,6 +767,24 @@ func _SignService_VerifyBatch_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SignService_VerifyInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyInclusion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyInclusion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyInclusion(ctx, req.(*VerifyInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SignService_SignStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(SignServiceServer).SignStream(&signServiceSignStreamServer{stream})
 }
@@ -319,14 +837,516 @@ func (x *signServiceVerifyStreamServer) Recv() (*VerifyRequest, error) {
 	return m, nil
 }
 
-// SignService_ServiceDesc is the grpc.ServiceDesc for SignService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SignService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "signservice.SignService",
-	HandlerType: (*SignServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
+func _SignService_SignDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Digest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignDigest(ctx, req.(*Digest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyDigest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyDigest(ctx, req.(*VerifyDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignLargeDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SignServiceServer).SignLargeDocument(&signServiceSignLargeDocumentServer{stream})
+}
+
+type SignService_SignLargeDocumentServer interface {
+	SendAndClose(*DocSign) error
+	Recv() (*LargeDocumentChunk, error)
+	grpc.ServerStream
+}
+
+type signServiceSignLargeDocumentServer struct {
+	grpc.ServerStream
+}
+
+func (x *signServiceSignLargeDocumentServer) SendAndClose(m *DocSign) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *signServiceSignLargeDocumentServer) Recv() (*LargeDocumentChunk, error) {
+	m := new(LargeDocumentChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SignService_VerifyLargeDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SignServiceServer).VerifyLargeDocument(&signServiceVerifyLargeDocumentServer{stream})
+}
+
+type SignService_VerifyLargeDocumentServer interface {
+	SendAndClose(*VerifyResponse) error
+	Recv() (*LargeDocumentChunk, error)
+	grpc.ServerStream
+}
+
+type signServiceVerifyLargeDocumentServer struct {
+	grpc.ServerStream
+}
+
+func (x *signServiceVerifyLargeDocumentServer) SendAndClose(m *VerifyResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *signServiceVerifyLargeDocumentServer) Recv() (*LargeDocumentChunk, error) {
+	m := new(LargeDocumentChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SignService_SignJWS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignJWSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignJWS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignJWS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignJWS(ctx, req.(*SignJWSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyJWS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyJWSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyJWS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyJWS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyJWS(ctx, req.(*VerifyJWSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignCOSE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCOSERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignCOSE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignCOSE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignCOSE(ctx, req.(*SignCOSERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyCOSE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCOSERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyCOSE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyCOSE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyCOSE(ctx, req.(*VerifyCOSERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignCMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignCMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignCMS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignCMS(ctx, req.(*SignCMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyCMS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCMSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyCMS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyCMS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyCMS(ctx, req.(*VerifyCMSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignOpenPGP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignOpenPGPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignOpenPGP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignOpenPGP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignOpenPGP(ctx, req.(*SignOpenPGPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_GetOpenPGPPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenPGPPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).GetOpenPGPPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_GetOpenPGPPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).GetOpenPGPPublicKey(ctx, req.(*GetOpenPGPPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignSSH_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSSHRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignSSH(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignSSH_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignSSH(ctx, req.(*SignSSHRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifySSH_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySSHRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifySSH(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifySSH_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifySSH(ctx, req.(*VerifySSHRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignMinisign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMinisignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignMinisign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignMinisign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignMinisign(ctx, req.(*SignMinisignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyMinisign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMinisignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyMinisign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyMinisign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyMinisign(ctx, req.(*VerifyMinisignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_GetMinisignPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinisignPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).GetMinisignPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_GetMinisignPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).GetMinisignPublicKey(ctx, req.(*GetMinisignPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignDSSE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignDSSERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignDSSE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignDSSE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignDSSE(ctx, req.(*SignDSSERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyDSSE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDSSERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyDSSE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyDSSE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyDSSE(ctx, req.(*VerifyDSSERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignCSR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignCSRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignCSR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignCSR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignCSR(ctx, req.(*SignCSRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_IssueCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).IssueCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_IssueCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).IssueCertificate(ctx, req.(*IssueCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_Timestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).Timestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_Timestamp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).Timestamp(ctx, req.(*TimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyTimestamp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyTimestamp(ctx, req.(*VerifyTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_SignHTTPMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignHTTPMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).SignHTTPMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_SignHTTPMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).SignHTTPMessage(ctx, req.(*SignHTTPMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_VerifyHTTPMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyHTTPMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).VerifyHTTPMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_VerifyHTTPMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).VerifyHTTPMessage(ctx, req.(*VerifyHTTPMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SignService_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SignServiceServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SignService_ListKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SignServiceServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SignService_ServiceDesc is the grpc.ServiceDesc for SignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "signservice.SignService",
+	HandlerType: (*SignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
 			MethodName: "Sign",
 			Handler:    _SignService_Sign_Handler,
 		},
@@ -342,6 +1362,110 @@ var SignService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyBatch",
 			Handler:    _SignService_VerifyBatch_Handler,
 		},
+		{
+			MethodName: "VerifyInclusion",
+			Handler:    _SignService_VerifyInclusion_Handler,
+		},
+		{
+			MethodName: "SignDigest",
+			Handler:    _SignService_SignDigest_Handler,
+		},
+		{
+			MethodName: "VerifyDigest",
+			Handler:    _SignService_VerifyDigest_Handler,
+		},
+		{
+			MethodName: "SignJWS",
+			Handler:    _SignService_SignJWS_Handler,
+		},
+		{
+			MethodName: "VerifyJWS",
+			Handler:    _SignService_VerifyJWS_Handler,
+		},
+		{
+			MethodName: "SignCOSE",
+			Handler:    _SignService_SignCOSE_Handler,
+		},
+		{
+			MethodName: "VerifyCOSE",
+			Handler:    _SignService_VerifyCOSE_Handler,
+		},
+		{
+			MethodName: "SignCMS",
+			Handler:    _SignService_SignCMS_Handler,
+		},
+		{
+			MethodName: "VerifyCMS",
+			Handler:    _SignService_VerifyCMS_Handler,
+		},
+		{
+			MethodName: "SignOpenPGP",
+			Handler:    _SignService_SignOpenPGP_Handler,
+		},
+		{
+			MethodName: "GetOpenPGPPublicKey",
+			Handler:    _SignService_GetOpenPGPPublicKey_Handler,
+		},
+		{
+			MethodName: "SignSSH",
+			Handler:    _SignService_SignSSH_Handler,
+		},
+		{
+			MethodName: "VerifySSH",
+			Handler:    _SignService_VerifySSH_Handler,
+		},
+		{
+			MethodName: "SignMinisign",
+			Handler:    _SignService_SignMinisign_Handler,
+		},
+		{
+			MethodName: "VerifyMinisign",
+			Handler:    _SignService_VerifyMinisign_Handler,
+		},
+		{
+			MethodName: "GetMinisignPublicKey",
+			Handler:    _SignService_GetMinisignPublicKey_Handler,
+		},
+		{
+			MethodName: "SignDSSE",
+			Handler:    _SignService_SignDSSE_Handler,
+		},
+		{
+			MethodName: "VerifyDSSE",
+			Handler:    _SignService_VerifyDSSE_Handler,
+		},
+		{
+			MethodName: "SignCSR",
+			Handler:    _SignService_SignCSR_Handler,
+		},
+		{
+			MethodName: "IssueCertificate",
+			Handler:    _SignService_IssueCertificate_Handler,
+		},
+		{
+			MethodName: "Timestamp",
+			Handler:    _SignService_Timestamp_Handler,
+		},
+		{
+			MethodName: "VerifyTimestamp",
+			Handler:    _SignService_VerifyTimestamp_Handler,
+		},
+		{
+			MethodName: "SignHTTPMessage",
+			Handler:    _SignService_SignHTTPMessage_Handler,
+		},
+		{
+			MethodName: "VerifyHTTPMessage",
+			Handler:    _SignService_VerifyHTTPMessage_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _SignService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "ListKeys",
+			Handler:    _SignService_ListKeys_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -356,6 +1480,16 @@ var SignService_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "SignLargeDocument",
+			Handler:       _SignService_SignLargeDocument_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "VerifyLargeDocument",
+			Handler:       _SignService_VerifyLargeDocument_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "proto/service.proto",
 }
@@ -0,0 +1,204 @@
+// Package sshsig implements the SSHSIG signature format of OpenSSH (PROTOCOL.sshsig), the format of
+// `ssh-keygen -Y sign` and of git commits signed with SSH keys.
+package sshsig
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	_magic   = "SSHSIG"
+	_version = 1
+
+	_armorBegin = "-----BEGIN SSH SIGNATURE-----"
+	_armorEnd   = "-----END SSH SIGNATURE-----"
+	// ssh-keygen wraps armored signatures at 70 columns.
+	_armorWidth = 70
+
+	HashSHA256 = "sha256"
+	HashSHA512 = "sha512"
+)
+
+var (
+	ErrMalformed          = errors.New("malformed SSH signature")
+	ErrNamespaceMismatch  = errors.New("SSH signature namespace mismatch")
+	ErrSignatureMismatch  = errors.New("SSH signature mismatch")
+	ErrUnsupportedHash    = errors.New("unsupported SSH signature hash")
+	ErrNamespaceEmpty     = errors.New("empty SSH signature namespace")
+	ErrUnsupportedVersion = errors.New("unsupported SSH signature version")
+)
+
+// Signature is a decoded SSHSIG signature.
+type Signature struct {
+	PublicKey     ssh.PublicKey
+	Namespace     string
+	HashAlgorithm string
+	Signature     *ssh.Signature
+}
+
+type wireSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+type signedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// Sign signs message within namespace, e.g. "git" or "file", with a SHA-512 message hash. RSA keys sign with
+// rsa-sha2-512 as ssh-keygen does.
+func Sign(signer ssh.Signer, namespace string, message []byte) (*Signature, error) {
+	if namespace == "" {
+		return nil, ErrNamespaceEmpty
+	}
+
+	data, err := toBeSigned(namespace, HashSHA512, message)
+	if err != nil {
+		return nil, err
+	}
+
+	var signature *ssh.Signature
+	if algorithmSigner, ok := signer.(ssh.AlgorithmSigner); ok && signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		signature, err = algorithmSigner.SignWithAlgorithm(rand.Reader, data, ssh.KeyAlgoRSASHA512)
+	} else {
+		signature, err = signer.Sign(rand.Reader, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signature{
+		PublicKey:     signer.PublicKey(),
+		Namespace:     namespace,
+		HashAlgorithm: HashSHA512,
+		Signature:     signature,
+	}, nil
+}
+
+// Verify checks that the signature is made over message within namespace by s.PublicKey.
+func (s *Signature) Verify(namespace string, message []byte) error {
+	if s.Namespace != namespace {
+		return fmt.Errorf("%w: %q, expected %q", ErrNamespaceMismatch, s.Namespace, namespace)
+	}
+
+	data, err := toBeSigned(s.Namespace, s.HashAlgorithm, message)
+	if err != nil {
+		return err
+	}
+
+	if err := s.PublicKey.Verify(data, s.Signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrSignatureMismatch, err)
+	}
+	return nil
+}
+
+// Marshal returns the binary form of the signature.
+func (s *Signature) Marshal() []byte {
+	return append([]byte(_magic), ssh.Marshal(wireSignature{
+		Version:       _version,
+		PublicKey:     s.PublicKey.Marshal(),
+		Namespace:     s.Namespace,
+		HashAlgorithm: s.HashAlgorithm,
+		Signature:     ssh.Marshal(s.Signature),
+	})...)
+}
+
+// Armor returns the signature as an "SSH SIGNATURE" block, the form written by ssh-keygen.
+func (s *Signature) Armor() []byte {
+	encoded := base64.StdEncoding.EncodeToString(s.Marshal())
+
+	var armored bytes.Buffer
+	armored.WriteString(_armorBegin + "\n")
+	for len(encoded) > _armorWidth {
+		armored.WriteString(encoded[:_armorWidth] + "\n")
+		encoded = encoded[_armorWidth:]
+	}
+	armored.WriteString(encoded + "\n")
+	armored.WriteString(_armorEnd + "\n")
+	return armored.Bytes()
+}
+
+// Parse decodes a binary signature.
+func Parse(data []byte) (*Signature, error) {
+	if !bytes.HasPrefix(data, []byte(_magic)) {
+		return nil, fmt.Errorf("%w: no %s preamble", ErrMalformed, _magic)
+	}
+
+	var wire wireSignature
+	if err := ssh.Unmarshal(data[len(_magic):], &wire); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+
+	if wire.Version != _version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, wire.Version)
+	}
+
+	publicKey, err := ssh.ParsePublicKey(wire.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: public key: %v", ErrMalformed, err)
+	}
+
+	signature := &ssh.Signature{}
+	if err := ssh.Unmarshal(wire.Signature, signature); err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrMalformed, err)
+	}
+
+	return &Signature{
+		PublicKey:     publicKey,
+		Namespace:     wire.Namespace,
+		HashAlgorithm: wire.HashAlgorithm,
+		Signature:     signature,
+	}, nil
+}
+
+// ParseArmored decodes an "SSH SIGNATURE" block.
+func ParseArmored(data []byte) (*Signature, error) {
+	data = bytes.TrimSpace(data)
+	if !bytes.HasPrefix(data, []byte(_armorBegin)) || !bytes.HasSuffix(data, []byte(_armorEnd)) {
+		return nil, fmt.Errorf("%w: no armor", ErrMalformed)
+	}
+
+	body := bytes.Join(bytes.Fields(data[len(_armorBegin):len(data)-len(_armorEnd)]), nil)
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(body)))
+	n, err := base64.StdEncoding.Decode(decoded, body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	return Parse(decoded[:n])
+}
+
+// toBeSigned returns the blob a signature is made over: the preamble, the namespace and the message hash.
+func toBeSigned(namespace, hashAlgorithm string, message []byte) ([]byte, error) {
+	var digest []byte
+	switch hashAlgorithm {
+	case HashSHA256:
+		sum := sha256.Sum256(message)
+		digest = sum[:]
+	case HashSHA512:
+		sum := sha512.Sum512(message)
+		digest = sum[:]
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedHash, hashAlgorithm)
+	}
+
+	return append([]byte(_magic), ssh.Marshal(signedData{
+		Namespace:     namespace,
+		HashAlgorithm: hashAlgorithm,
+		Hash:          digest,
+	})...), nil
+}
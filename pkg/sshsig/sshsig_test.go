@@ -0,0 +1,100 @@
+package sshsig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+// Made by `ssh-keygen -Y sign -n file` over "hello\n".
+const (
+	_sshKeygenPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIC1E8R5+tVbuC6TNUJXGVZJvzmUZBfp5EUJCTLEg3jF"
+	_sshKeygenSignature = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAggLUTxHn61Vu4LpM1QlcZVkm/OZ
+RkF+nkRQkJMsSDeMUAAAAEZmlsZQAAAAAAAAAGc2hhNTEyAAAAUwAAAAtzc2gtZWQyNTUx
+OQAAAEAUFF2MZNm5dTCBqMTZTAzEfNQkUvtx5h96L408xU7LHKNcLTlvEHrBfU4BSgnlLE
+r8wwRuiLaKMChMFQZccqEB
+-----END SSH SIGNATURE-----
+`
+)
+
+func TestParseArmored(t *testing.T) {
+	t.Parallel()
+
+	signature, err := ParseArmored([]byte(_sshKeygenSignature))
+	assert.NoError(t, err)
+	assert.Equal(t, "file", signature.Namespace)
+	assert.Equal(t, HashSHA512, signature.HashAlgorithm)
+	assert.Equal(t, _sshKeygenPublicKey, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(signature.PublicKey))))
+
+	assert.NoError(t, signature.Verify("file", []byte("hello\n")))
+	assert.ErrorIs(t, signature.Verify("git", []byte("hello\n")), ErrNamespaceMismatch)
+	assert.ErrorIs(t, signature.Verify("file", []byte("hello")), ErrSignatureMismatch)
+
+	// Armoring is the exact inverse of parsing.
+	assert.Equal(t, _sshKeygenSignature, string(signature.Armor()))
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Case #1", data: ""},
+		{name: "Case #2", data: strings.Replace(_sshKeygenSignature, "BEGIN", "START", 1)},
+		{name: "Case #3", data: strings.Replace(_sshKeygenSignature, "U1NIU0lH", "U1NIU0lI", 1)},
+		{name: "Case #4", data: strings.Replace(_sshKeygenSignature, "AAAAAQ", "AAAAAg", 1)},
+		{name: "Case #5", data: strings.Replace(_sshKeygenSignature, "r8wwRuiLaKMChMFQZccqEB", "r8ww", 1)},
+		{name: "Case #6", data: strings.Replace(_sshKeygenSignature, "U1NI", "U1N*", 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseArmored([]byte(tt.data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	message := []byte("message")
+	for _, key := range []crypto.Signer{ed25519Key, ecdsaKey, rsaKey} {
+		signer, err := ssh.NewSignerFromSigner(key)
+		assert.NoError(t, err)
+
+		signature, err := Sign(signer, "git", message)
+		assert.NoError(t, err)
+
+		parsed, err := ParseArmored(signature.Armor())
+		assert.NoError(t, err)
+		assert.Equal(t, signature.Marshal(), parsed.Marshal())
+		assert.NoError(t, parsed.Verify("git", message))
+		assert.Error(t, parsed.Verify("git", message[1:]))
+
+		if signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+			assert.Equal(t, ssh.KeyAlgoRSASHA512, parsed.Signature.Format)
+		}
+
+		for _, line := range strings.Split(strings.TrimSpace(string(signature.Armor())), "\n") {
+			assert.LessOrEqual(t, len(line), _armorWidth)
+		}
+
+		_, err = Sign(signer, "", message)
+		assert.ErrorIs(t, err, ErrNamespaceEmpty)
+	}
+}